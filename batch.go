@@ -0,0 +1,106 @@
+package gohelpers
+
+import (
+    "context"
+    "time"
+)
+
+// Timer is the subset of *time.Timer used by Batch, so tests can substitute a fake clock
+type Timer interface {
+    C() <-chan time.Time
+    Stop() bool
+}
+
+// Clock creates timers; RealClock uses the time package
+type Clock interface {
+    NewTimer(d time.Duration) Timer
+}
+
+type realClock struct{}
+
+type realTimer struct {
+    *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+    return t.Timer.C
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+    return realTimer{time.NewTimer(d)}
+}
+
+// RealClock is the Clock backed by the time package
+var RealClock Clock = realClock{}
+
+// Batch groups values from in into slices of at most maxSize (at least 1). A batch is emitted when it
+// is full or when maxWait has passed since its first value arrived; a maxWait of zero or less disables
+// the timer. When in is closed the pending batch is flushed and the output is closed. When ctx is
+// cancelled the pending batch is dropped and the output is closed.
+func Batch[T any](ctx context.Context, in <-chan T, maxSize int, maxWait time.Duration) <-chan []T {
+    return BatchWithClock(ctx, in, maxSize, maxWait, RealClock)
+}
+
+// BatchWithClock is like Batch but measures maxWait with the given clock
+func BatchWithClock[T any](ctx context.Context, in <-chan T, maxSize int, maxWait time.Duration, clock Clock) <-chan []T {
+    maxSize = Max(maxSize, 1)
+    out := make(chan []T)
+
+    go func() {
+        defer close(out)
+        var (
+            batch   []T
+            timer   Timer
+            expired <-chan time.Time
+        )
+        stopTimer := func() {
+            if timer != nil {
+                timer.Stop()
+                timer, expired = nil, nil
+            }
+        }
+        defer stopTimer()
+        flush := func() bool {
+            stopTimer()
+            if len(batch) == 0 {
+                return true
+            }
+            select {
+            case out <- batch:
+                batch = nil
+                return true
+            case <-ctx.Done():
+                return false
+            }
+        }
+
+        for {
+            select {
+            case v, ok := <-in:
+                if !ok {
+                    flush()
+                    return
+                }
+                if batch == nil {
+                    batch = make([]T, 0, maxSize)
+                    if maxWait > 0 {
+                        timer = clock.NewTimer(maxWait)
+                        expired = timer.C()
+                    }
+                }
+                batch = append(batch, v)
+                if len(batch) == maxSize && !flush() {
+                    return
+                }
+            case <-expired:
+                timer, expired = nil, nil
+                if !flush() {
+                    return
+                }
+            case <-ctx.Done():
+                return
+            }
+        }
+    }()
+    return out
+}
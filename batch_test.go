@@ -0,0 +1,190 @@
+package gohelpers
+
+import (
+    "context"
+    "reflect"
+    "sync"
+    "testing"
+    "time"
+)
+
+// fakeClock only fires timers when Advance is called, so batching tests never depend on real time
+type fakeClock struct {
+    mu      sync.Mutex
+    now     time.Duration
+    timers  []*fakeTimer
+    created chan struct{}
+}
+
+type fakeTimer struct {
+    clock    *fakeClock
+    deadline time.Duration
+    c        chan time.Time
+    stopped  bool
+}
+
+func newFakeClock() *fakeClock {
+    return &fakeClock{created: make(chan struct{}, 100)}
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    t := &fakeTimer{clock: c, deadline: c.now + d, c: make(chan time.Time, 1)}
+    c.timers = append(c.timers, t)
+    c.created <- struct{}{}
+    return t
+}
+
+// waitForTimer blocks until the code under test has started a timer
+func (c *fakeClock) waitForTimer(t *testing.T) {
+    t.Helper()
+    select {
+    case <-c.created:
+    case <-time.After(time.Second):
+        t.Fatal("timed out waiting for a timer to start")
+    }
+}
+
+// Advance moves the clock forward and fires every timer that is due
+func (c *fakeClock) Advance(d time.Duration) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.now += d
+    for _, t := range c.timers {
+        if !t.stopped && t.deadline <= c.now {
+            t.stopped = true
+            t.c <- time.Time{}
+        }
+    }
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+    return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+    t.clock.mu.Lock()
+    defer t.clock.mu.Unlock()
+    wasActive := !t.stopped
+    t.stopped = true
+    return wasActive
+}
+
+// receive reads one batch or fails the test if none arrives
+func receive[T any](t *testing.T, out <-chan []T) ([]T, bool) {
+    t.Helper()
+    select {
+    case batch, ok := <-out:
+        return batch, ok
+    case <-time.After(time.Second):
+        t.Fatal("timed out waiting for a batch")
+        return nil, false
+    }
+}
+
+func TestBatchBySize(t *testing.T) {
+    in := make(chan int)
+    out := BatchWithClock(context.Background(), in, 3, time.Minute, newFakeClock())
+
+    go func() {
+        for i := 1; i <= 7; i++ {
+            in <- i
+        }
+        close(in)
+    }()
+
+    var got [][]int
+    for batch := range out {
+        got = append(got, batch)
+    }
+    if !reflect.DeepEqual(got, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}) {
+        t.Errorf("Batch() = %v, want batches of 3 with the rest flushed on close", got)
+    }
+}
+
+func TestBatchByTime(t *testing.T) {
+    clock := newFakeClock()
+    in := make(chan string)
+    out := BatchWithClock(context.Background(), in, 10, 5*time.Second, clock)
+
+    in <- "a"
+    clock.waitForTimer(t)
+    in <- "b"
+    clock.Advance(4 * time.Second)
+    in <- "c"
+    clock.Advance(time.Second)
+    if batch, _ := receive(t, out); !reflect.DeepEqual(batch, []string{"a", "b", "c"}) {
+        t.Errorf("first batch = %v, want [a b c]", batch)
+    }
+
+    // The next timer starts with the next batch's first value, not when the previous batch was sent
+    clock.Advance(time.Hour)
+    in <- "d"
+    clock.waitForTimer(t)
+    clock.Advance(5 * time.Second)
+    if batch, _ := receive(t, out); !reflect.DeepEqual(batch, []string{"d"}) {
+        t.Errorf("second batch = %v, want [d]", batch)
+    }
+
+    close(in)
+    if batch, ok := receive(t, out); ok {
+        t.Errorf("got batch %v after close, want output closed", batch)
+    }
+}
+
+func TestBatchSizeFlushStopsTimer(t *testing.T) {
+    clock := newFakeClock()
+    in := make(chan int)
+    out := BatchWithClock(context.Background(), in, 2, time.Second, clock)
+
+    go func() {
+        in <- 1
+        in <- 2
+        in <- 3
+    }()
+    if batch, _ := receive(t, out); !reflect.DeepEqual(batch, []int{1, 2}) {
+        t.Errorf("first batch = %v, want [1 2]", batch)
+    }
+    clock.waitForTimer(t)
+    clock.waitForTimer(t)
+    clock.mu.Lock()
+    firstStopped := clock.timers[0].stopped
+    clock.mu.Unlock()
+    if !firstStopped {
+        t.Errorf("timer of the full batch was not stopped")
+    }
+    clock.Advance(time.Second)
+    if batch, _ := receive(t, out); !reflect.DeepEqual(batch, []int{3}) {
+        t.Errorf("second batch = %v, want [3]", batch)
+    }
+}
+
+func TestBatchCancellation(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    clock := newFakeClock()
+    in := make(chan int)
+    out := BatchWithClock(ctx, in, 10, time.Second, clock)
+
+    in <- 1
+    cancel()
+    if batch, ok := receive(t, out); ok {
+        t.Errorf("got batch %v after cancellation, want output closed", batch)
+    }
+}
+
+func TestBatchWithoutTimer(t *testing.T) {
+    in := make(chan int, 3)
+    in <- 1
+    in <- 2
+    close(in)
+    out := Batch(context.Background(), in, 0, 0)
+
+    var got [][]int
+    for batch := range out {
+        got = append(got, batch)
+    }
+    if !reflect.DeepEqual(got, [][]int{{1}, {2}}) {
+        t.Errorf("Batch() with maxSize 0 = %v, want batches of 1", got)
+    }
+}
@@ -0,0 +1,219 @@
+// Package chans provides context-aware channel pipeline stages. Every stage runs in its own
+// goroutines, closes its outputs when its input is exhausted, and exits promptly when its
+// context is cancelled, so abandoned pipelines do not leak goroutines.
+package chans
+
+import (
+    "context"
+    "iter"
+    "sync"
+)
+
+// send delivers v on out unless ctx is cancelled first, reporting whether it was sent
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+    select {
+    case out <- v:
+        return true
+    case <-ctx.Done():
+        return false
+    }
+}
+
+// Generator sends the elements of a slice, such as one built by gohelpers.Range, and then closes the channel
+func Generator[T any](ctx context.Context, slice []T) <-chan T {
+    out := make(chan T)
+    go func() {
+        defer close(out)
+        for _, v := range slice {
+            if !send(ctx, out, v) {
+                return
+            }
+        }
+    }()
+    return out
+}
+
+// GeneratorSeq sends the values of a sequence, such as gohelpers.RangeSeq, and then closes the channel
+func GeneratorSeq[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+    out := make(chan T)
+    go func() {
+        defer close(out)
+        for v := range seq {
+            if !send(ctx, out, v) {
+                return
+            }
+        }
+    }()
+    return out
+}
+
+// OrDone forwards values from in until it is closed or ctx is cancelled, so callers can range over
+// a channel they do not control without blocking forever
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+    out := make(chan T)
+    go func() {
+        defer close(out)
+        for {
+            select {
+            case v, ok := <-in:
+                if !ok || !send(ctx, out, v) {
+                    return
+                }
+            case <-ctx.Done():
+                return
+            }
+        }
+    }()
+    return out
+}
+
+// workerPool runs workers goroutines (at least 1) that each call work, closing out once all of them return
+func workerPool[T any](workers int, out chan T, work func()) {
+    var wg sync.WaitGroup
+    for w := 0; w < max(workers, 1); w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            work()
+        }()
+    }
+    go func() {
+        wg.Wait()
+        close(out)
+    }()
+}
+
+// MapChan applies f to every value from in using the given number of workers; output order is not preserved
+func MapChan[T, U any](ctx context.Context, in <-chan T, workers int, f func(T) U) <-chan U {
+    out := make(chan U)
+    workerPool(workers, out, func() {
+        for v := range OrDone(ctx, in) {
+            if !send(ctx, out, f(v)) {
+                return
+            }
+        }
+    })
+    return out
+}
+
+// FilterChan forwards the values from in that pass the test using the given number of workers;
+// output order is not preserved
+func FilterChan[T any](ctx context.Context, in <-chan T, workers int, f func(T) bool) <-chan T {
+    out := make(chan T)
+    workerPool(workers, out, func() {
+        for v := range OrDone(ctx, in) {
+            if f(v) && !send(ctx, out, v) {
+                return
+            }
+        }
+    })
+    return out
+}
+
+// FanIn merges several channels into one, closing it once every input is closed
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+    out := make(chan T)
+    var wg sync.WaitGroup
+    for _, in := range ins {
+        wg.Add(1)
+        go func(in <-chan T) {
+            defer wg.Done()
+            for v := range OrDone(ctx, in) {
+                if !send(ctx, out, v) {
+                    return
+                }
+            }
+        }(in)
+    }
+    go func() {
+        wg.Wait()
+        close(out)
+    }()
+    return out
+}
+
+// Merge is an alias for FanIn
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+    return FanIn(ctx, ins...)
+}
+
+// FanOut spreads the values from in across n channels (at least 1); each value goes to exactly one
+// output, whichever is ready to take it
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+    outs := make([]<-chan T, max(n, 1))
+    for i := range outs {
+        out := make(chan T)
+        outs[i] = out
+        go func() {
+            defer close(out)
+            for v := range OrDone(ctx, in) {
+                if !send(ctx, out, v) {
+                    return
+                }
+            }
+        }()
+    }
+    return outs
+}
+
+// Tee copies every value from in to both outputs. Each value is delivered to both before the next is
+// read, so a slow reader on either side slows down both.
+func Tee[T any](ctx context.Context, in <-chan T) (<-chan T, <-chan T) {
+    out1, out2 := make(chan T), make(chan T)
+    go func() {
+        defer close(out1)
+        defer close(out2)
+        for v := range OrDone(ctx, in) {
+            // Disable each output once it has received v by setting it to nil
+            a, b := out1, out2
+            for a != nil || b != nil {
+                select {
+                case a <- v:
+                    a = nil
+                case b <- v:
+                    b = nil
+                case <-ctx.Done():
+                    return
+                }
+            }
+        }
+    }()
+    return out1, out2
+}
+
+// MergeSorted merges channels that each deliver values in ascending order according to less into
+// a single ascending channel. It waits for a value (or close) from every input before each send.
+func MergeSorted[T any](ctx context.Context, less func(a, b T) bool, ins ...<-chan T) <-chan T {
+    out := make(chan T)
+    go func() {
+        defer close(out)
+        heads := make([]T, len(ins))
+        open := make([]bool, len(ins))
+        receive := func(i int) bool {
+            select {
+            case v, ok := <-ins[i]:
+                heads[i], open[i] = v, ok
+                return true
+            case <-ctx.Done():
+                return false
+            }
+        }
+        for i := range ins {
+            if !receive(i) {
+                return
+            }
+        }
+        for {
+            best := -1
+            for i := range ins {
+                if open[i] && (best < 0 || less(heads[i], heads[best])) {
+                    best = i
+                }
+            }
+            if best < 0 || !send(ctx, out, heads[best]) || !receive(best) {
+                return
+            }
+        }
+    }()
+    return out
+}
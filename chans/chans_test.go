@@ -0,0 +1,176 @@
+package chans
+
+import (
+    "context"
+    "reflect"
+    "runtime"
+    "sort"
+    "strings"
+    "testing"
+    "time"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+// verifyNoLeaks fails the test if goroutines started by this package are still running once the test
+// ends, in the spirit of go.uber.org/goleak
+func verifyNoLeaks(t *testing.T) {
+    t.Helper()
+    t.Cleanup(func() {
+        deadline := time.Now().Add(time.Second)
+        for {
+            leaked := leakedGoroutines()
+            if len(leaked) == 0 {
+                return
+            }
+            if time.Now().After(deadline) {
+                t.Errorf("leaked %d goroutines:\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+                return
+            }
+            time.Sleep(5 * time.Millisecond)
+        }
+    })
+}
+
+// leakedGoroutines returns the stacks of goroutines running code from this package outside of tests
+func leakedGoroutines() []string {
+    buf := make([]byte, 1<<20)
+    buf = buf[:runtime.Stack(buf, true)]
+    var leaked []string
+    for _, g := range strings.Split(string(buf), "\n\n") {
+        if strings.Contains(g, "go_helpers/chans.") && !strings.Contains(g, "chans.Test") && !strings.Contains(g, "chans.verifyNoLeaks") {
+            leaked = append(leaked, g)
+        }
+    }
+    return leaked
+}
+
+// collect drains a channel into a slice
+func collect[T any](ch <-chan T) []T {
+    result := make([]T, 0)
+    for v := range ch {
+        result = append(result, v)
+    }
+    return result
+}
+
+func TestGenerator(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx := context.Background()
+
+    if got := collect(Generator(ctx, gohelpers.Range(0, 5))); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+        t.Errorf("Generator() = %v", got)
+    }
+    if got := collect(GeneratorSeq(ctx, gohelpers.RangeSeq(10, 0, -3))); !reflect.DeepEqual(got, []int{10, 7, 4, 1}) {
+        t.Errorf("GeneratorSeq() = %v", got)
+    }
+}
+
+func TestMapAndFilterChan(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx := context.Background()
+    nums := gohelpers.Range(0, 100)
+    square := func(x int) int { return x * x }
+    isEven := func(x int) bool { return x%2 == 0 }
+
+    got := collect(MapChan(ctx, Generator(ctx, nums), 4, square))
+    sort.Ints(got)
+    if !reflect.DeepEqual(got, gohelpers.Map(nums, square)) {
+        t.Errorf("MapChan() = %v", got)
+    }
+
+    got = collect(FilterChan(ctx, Generator(ctx, nums), 3, isEven))
+    sort.Ints(got)
+    if !reflect.DeepEqual(got, gohelpers.Filter(nums, isEven)) {
+        t.Errorf("FilterChan() = %v", got)
+    }
+}
+
+func TestFanInAndFanOut(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx := context.Background()
+
+    merged := collect(Merge(ctx, Generator(ctx, []int{1, 2}), Generator(ctx, []int{3}), Generator(ctx, []int{})))
+    sort.Ints(merged)
+    if !reflect.DeepEqual(merged, []int{1, 2, 3}) {
+        t.Errorf("Merge() = %v", merged)
+    }
+
+    outs := FanOut(ctx, Generator(ctx, gohelpers.Range(0, 50)), 3)
+    if len(outs) != 3 {
+        t.Fatalf("FanOut() returned %d channels, want 3", len(outs))
+    }
+    got := collect(FanIn(ctx, outs...))
+    sort.Ints(got)
+    if !reflect.DeepEqual(got, gohelpers.Range(0, 50)) {
+        t.Errorf("FanIn(FanOut()) = %v, want every value exactly once", got)
+    }
+}
+
+func TestTee(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx := context.Background()
+    a, b := Tee(ctx, Generator(ctx, []string{"x", "y", "z"}))
+
+    done := make(chan []string)
+    go func() { done <- collect(b) }()
+    gotA := collect(a)
+    gotB := <-done
+    if !reflect.DeepEqual(gotA, []string{"x", "y", "z"}) || !reflect.DeepEqual(gotB, gotA) {
+        t.Errorf("Tee() = %v and %v", gotA, gotB)
+    }
+}
+
+func TestMergeSorted(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx := context.Background()
+    less := func(a, b int) bool { return a < b }
+
+    got := collect(MergeSorted(ctx, less,
+        Generator(ctx, []int{1, 4, 9}),
+        Generator(ctx, []int{2, 3, 10, 11}),
+        Generator(ctx, []int{}),
+        Generator(ctx, []int{0, 5}),
+    ))
+    if !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4, 5, 9, 10, 11}) {
+        t.Errorf("MergeSorted() = %v", got)
+    }
+    if got := collect(MergeSorted[int](ctx, less)); len(got) != 0 {
+        t.Errorf("MergeSorted() with no inputs = %v", got)
+    }
+}
+
+func TestOrDone(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx, cancel := context.WithCancel(context.Background())
+    never := make(chan int)
+
+    out := OrDone(ctx, never)
+    cancel()
+    select {
+    case _, ok := <-out:
+        if ok {
+            t.Errorf("OrDone() delivered a value after cancellation")
+        }
+    case <-time.After(time.Second):
+        t.Fatal("OrDone() did not close after cancellation")
+    }
+}
+
+func TestCancellationDoesNotLeak(t *testing.T) {
+    verifyNoLeaks(t)
+    ctx, cancel := context.WithCancel(context.Background())
+
+    // An endless pipeline that is abandoned part way through
+    source := GeneratorSeq(ctx, gohelpers.RangeSeq(0, 1<<62, 1))
+    outs := FanOut(ctx, source, 3)
+    mapped := MapChan(ctx, FanIn(ctx, outs...), 4, func(x int) int { return x * 2 })
+    filtered := FilterChan(ctx, mapped, 2, func(x int) bool { return x%3 == 0 })
+    a, b := Tee(ctx, filtered)
+    merged := MergeSorted(ctx, func(x, y int) bool { return x < y }, OrDone(ctx, a))
+
+    <-merged
+    <-b
+    cancel()
+    // Readers that stop reading must not keep any stage alive
+}
@@ -0,0 +1,295 @@
+package gohelpers
+
+import (
+    "errors"
+    "fmt"
+    "math"
+    "math/big"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+var (
+    // ErrInvalidDecimal is returned when a string cannot be parsed as a Decimal
+    ErrInvalidDecimal = errors.New("invalid decimal")
+    // ErrDivisionByZero is returned when dividing a Decimal by zero
+    ErrDivisionByZero = errors.New("division by zero")
+    // ErrInvalidRatios is returned by Allocate for empty, negative or all-zero ratios
+    ErrInvalidRatios = errors.New("invalid allocation ratios")
+)
+
+// Decimal is an arbitrary-precision decimal number with a fixed number of digits after the decimal point.
+// A Decimal is an immutable value; every operation returns a new Decimal. The zero value is 0 with scale 0.
+type Decimal struct {
+    value *big.Int
+    scale int
+}
+
+// maxDecimalExponent bounds the exponent accepted by ParseDecimal, so input such as "1e999999999"
+// cannot force a huge allocation
+const maxDecimalExponent = 10000
+
+// NewDecimal returns the Decimal unscaled * 10^-scale, so NewDecimal(1234, 2) is 12.34. A negative
+// scale multiplies instead, so NewDecimal(5, -2) is 500 with scale 0.
+func NewDecimal(unscaled int64, scale int) Decimal {
+    value := big.NewInt(unscaled)
+    if scale < 0 {
+        return Decimal{value: value.Mul(value, pow10Big(-scale))}
+    }
+    return Decimal{value: value, scale: scale}
+}
+
+// ParseDecimal parses a string such as "-12.340" or, as in JSON numbers, "1.5e3". The scale is the
+// number of digits after the decimal point, less the exponent and never below zero, so "1.5e3" is
+// 1500 with scale 0 and "1.5e-3" is 0.0015 with scale 4.
+func ParseDecimal(s string) (Decimal, error) {
+    digits := s
+    if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+        digits = s[1:]
+    }
+    exponent := 0
+    if i := strings.IndexAny(digits, "eE"); i >= 0 {
+        expPart := digits[i+1:]
+        if strings.HasPrefix(expPart, "-") || strings.HasPrefix(expPart, "+") {
+            expPart = expPart[1:]
+        }
+        exp, err := strconv.Atoi(digits[i+1:])
+        if expPart == "" || !IsNumeric(expPart) || err != nil || exp < -maxDecimalExponent || exp > maxDecimalExponent {
+            return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+        }
+        digits, exponent = digits[:i], exp
+    }
+    intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+    if intPart == "" && fracPart == "" || hasPoint && fracPart == "" ||
+        !IsNumeric(intPart) || !IsNumeric(fracPart) {
+        return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+    }
+    value, _ := new(big.Int).SetString(intPart+fracPart, 10)
+    if strings.HasPrefix(s, "-") {
+        value.Neg(value)
+    }
+    scale := len(fracPart) - exponent
+    if scale < 0 {
+        return Decimal{value: value.Mul(value, pow10Big(-scale))}, nil
+    }
+    return Decimal{value: value, scale: scale}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if the string cannot be parsed
+func MustParseDecimal(s string) Decimal {
+    d, err := ParseDecimal(s)
+    if err != nil {
+        panic(err)
+    }
+    return d
+}
+
+// DecimalFromFloat converts a finite float to a Decimal with the given scale, rounding with mode
+func DecimalFromFloat(x float64, scale int, mode RoundingMode) (Decimal, error) {
+    if math.IsNaN(x) || math.IsInf(x, 0) {
+        return Decimal{}, ErrNotFinite
+    }
+    return ParseDecimal(FormatDecimal(x, Max(scale, 0), mode))
+}
+
+// unscaled returns the unscaled value, treating the zero Decimal as 0
+func (d Decimal) unscaled() *big.Int {
+    if d.value == nil {
+        return new(big.Int)
+    }
+    return d.value
+}
+
+// Scale returns the number of digits after the decimal point
+func (d Decimal) Scale() int {
+    return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d
+func (d Decimal) Sign() int {
+    return d.unscaled().Sign()
+}
+
+// IsZero reports whether d is zero
+func (d Decimal) IsZero() bool {
+    return d.Sign() == 0
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+    return Decimal{value: new(big.Int).Neg(d.unscaled()), scale: d.scale}
+}
+
+// Abs returns the absolute value of d
+func (d Decimal) Abs() Decimal {
+    return Decimal{value: new(big.Int).Abs(d.unscaled()), scale: d.scale}
+}
+
+// Rescale returns d with the given scale, rounding with mode when digits are dropped
+func (d Decimal) Rescale(scale int, mode RoundingMode) Decimal {
+    scale = Max(scale, 0)
+    value := d.unscaled()
+    if scale >= d.scale {
+        return Decimal{value: new(big.Int).Mul(value, pow10Big(scale-d.scale)), scale: scale}
+    }
+    q := roundQuotient(new(big.Int).Abs(value), pow10Big(d.scale-scale), value.Sign() < 0, mode)
+    if value.Sign() < 0 {
+        q.Neg(q)
+    }
+    return Decimal{value: q, scale: scale}
+}
+
+// align returns the unscaled values of d and other at their common (largest) scale
+func (d Decimal) align(other Decimal) (*big.Int, *big.Int, int) {
+    scale := Max(d.scale, other.scale)
+    return d.Rescale(scale, RoundTruncate).unscaled(), other.Rescale(scale, RoundTruncate).unscaled(), scale
+}
+
+// Add returns d + other at the larger of the two scales
+func (d Decimal) Add(other Decimal) Decimal {
+    a, b, scale := d.align(other)
+    return Decimal{value: new(big.Int).Add(a, b), scale: scale}
+}
+
+// Sub returns d - other at the larger of the two scales
+func (d Decimal) Sub(other Decimal) Decimal {
+    a, b, scale := d.align(other)
+    return Decimal{value: new(big.Int).Sub(a, b), scale: scale}
+}
+
+// Mul returns the exact product d * other, whose scale is the sum of both scales
+func (d Decimal) Mul(other Decimal) Decimal {
+    return Decimal{value: new(big.Int).Mul(d.unscaled(), other.unscaled()), scale: d.scale + other.scale}
+}
+
+// Div returns d / other at the given scale, rounding with mode
+func (d Decimal) Div(other Decimal, scale int, mode RoundingMode) (Decimal, error) {
+    if other.IsZero() {
+        return Decimal{}, ErrDivisionByZero
+    }
+    scale = Max(scale, 0)
+    num := new(big.Int).Abs(d.unscaled())
+    den := new(big.Int).Abs(other.unscaled())
+    // d / other = num * 10^-d.scale / (den * 10^-other.scale), wanted as q * 10^-scale
+    if exp := scale + other.scale - d.scale; exp >= 0 {
+        num.Mul(num, pow10Big(exp))
+    } else {
+        den.Mul(den, pow10Big(-exp))
+    }
+    negative := d.Sign()*other.Sign() < 0
+    q := roundQuotient(num, den, negative, mode)
+    if negative {
+        q.Neg(q)
+    }
+    return Decimal{value: q, scale: scale}, nil
+}
+
+// Cmp compares d and other numerically and returns -1, 0 or +1; scale does not matter, so 1.5 equals 1.50
+func (d Decimal) Cmp(other Decimal) int {
+    a, b, _ := d.align(other)
+    return a.Cmp(b)
+}
+
+// Equal reports whether d and other are numerically equal
+func (d Decimal) Equal(other Decimal) bool {
+    return d.Cmp(other) == 0
+}
+
+// String returns d as a decimal string with exactly Scale digits after the decimal point
+func (d Decimal) String() string {
+    value := d.unscaled()
+    return formatScaled(new(big.Int).Abs(value), d.scale, value.Sign() < 0)
+}
+
+// Float64 returns the nearest float64 to d
+func (d Decimal) Float64() float64 {
+    f, _ := new(big.Rat).SetFrac(d.unscaled(), pow10Big(d.scale)).Float64()
+    return f
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d Decimal) MarshalText() ([]byte, error) {
+    return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Decimal) UnmarshalText(text []byte) error {
+    parsed, err := ParseDecimal(string(text))
+    if err != nil {
+        return err
+    }
+    *d = parsed
+    return nil
+}
+
+// MarshalJSON encodes d as a JSON string so no precision is lost to float64 decoding
+func (d Decimal) MarshalJSON() ([]byte, error) {
+    return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON accepts a JSON string or number; null leaves d unchanged
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+    s := string(data)
+    if s == "null" {
+        return nil
+    }
+    if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+        s = s[1 : len(s)-1]
+    }
+    return d.UnmarshalText([]byte(s))
+}
+
+// Allocate splits d across ratios without losing any units of its scale. Each part receives its
+// proportional share rounded towards zero, and the leftover units go one at a time to the parts
+// with the largest remainders, ties going to the earlier part. The parts always sum to d.
+func (d Decimal) Allocate(ratios []int) ([]Decimal, error) {
+    // Total the ratios in a big.Int, since large ratios can overflow an int sum
+    bigTotal := new(big.Int)
+    for _, ratio := range ratios {
+        if ratio < 0 {
+            return nil, ErrInvalidRatios
+        }
+        bigTotal.Add(bigTotal, big.NewInt(int64(ratio)))
+    }
+    if bigTotal.Sign() == 0 {
+        return nil, ErrInvalidRatios
+    }
+
+    value := d.unscaled()
+    sign := big.NewInt(int64(value.Sign()))
+    abs := new(big.Int).Abs(value)
+
+    shares := make([]*big.Int, len(ratios))
+    remainders := make([]*big.Int, len(ratios))
+    left := new(big.Int).Set(abs)
+    for i, ratio := range ratios {
+        product := new(big.Int).Mul(abs, big.NewInt(int64(ratio)))
+        shares[i], remainders[i] = product.QuoRem(product, bigTotal, new(big.Int))
+        left.Sub(left, shares[i])
+    }
+
+    order := Range(0, len(ratios))
+    sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]].Cmp(remainders[order[j]]) > 0 })
+    for i := 0; left.Sign() > 0; i++ {
+        shares[order[i]].Add(shares[order[i]], big.NewInt(1))
+        left.Sub(left, big.NewInt(1))
+    }
+
+    return Map(shares, func(share *big.Int) Decimal {
+        return Decimal{value: share.Mul(share, sign), scale: d.scale}
+    }), nil
+}
+
+// SumDecimal returns the exact sum of a slice of Decimals at the largest scale among them
+func SumDecimal(nums []Decimal) Decimal {
+    return Reduce(nums, Decimal{}, Decimal.Add)
+}
+
+// AverageDecimal returns the average of a slice of Decimals at the given scale, rounding with mode
+func AverageDecimal(nums []Decimal, scale int, mode RoundingMode) (Decimal, error) {
+    if len(nums) == 0 {
+        return Decimal{}, ErrEmptySlice
+    }
+    return SumDecimal(nums).Div(NewDecimal(int64(len(nums)), 0), scale, mode)
+}
@@ -0,0 +1,265 @@
+package gohelpers
+
+import (
+    "encoding/json"
+    "errors"
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+    tests := []struct {
+        input    string
+        expected string
+        scale    int
+        wantErr  bool
+    }{
+        {"12.34", "12.34", 2, false},
+        {"-0.050", "-0.050", 3, false},
+        {"+7", "7", 0, false},
+        {".5", "0.5", 1, false},
+        {"123456789012345678901234567890.12", "123456789012345678901234567890.12", 2, false},
+        {"", "", 0, true},
+        {"-", "", 0, true},
+        {"1.", "", 0, true},
+        {"1.2.3", "", 0, true},
+        {"1e5", "100000", 0, false},
+        {"1.5e-3", "0.0015", 4, false},
+        {"-2.50E+1", "-25.0", 1, false},
+        {"12.34e2", "1234", 0, false},
+        {"-+1", "", 0, true},
+        {"1e", "", 0, true},
+        {"1e+", "", 0, true},
+        {"1e5.5", "", 0, true},
+        {"e5", "", 0, true},
+        {"1e--5", "", 0, true},
+        {"1e99999", "", 0, true},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.input, func(t *testing.T) {
+            got, err := ParseDecimal(tt.input)
+            if tt.wantErr {
+                if !errors.Is(err, ErrInvalidDecimal) {
+                    t.Errorf("ParseDecimal(%q) error = %v, want %v", tt.input, err, ErrInvalidDecimal)
+                }
+                return
+            }
+            if err != nil {
+                t.Fatalf("ParseDecimal(%q) unexpected error: %v", tt.input, err)
+            }
+            if got.String() != tt.expected || got.Scale() != tt.scale {
+                t.Errorf("ParseDecimal(%q) = %v (scale %d), want %v (scale %d)", tt.input, got, got.Scale(), tt.expected, tt.scale)
+            }
+        })
+    }
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+    a := MustParseDecimal("10.25")
+    b := MustParseDecimal("0.1")
+
+    tests := []struct {
+        name     string
+        got      Decimal
+        expected string
+    }{
+        {"add", a.Add(b), "10.35"},
+        {"sub", b.Sub(a), "-10.15"},
+        {"mul", a.Mul(b), "1.025"},
+        {"neg", a.Neg(), "-10.25"},
+        {"abs", a.Neg().Abs(), "10.25"},
+        {"zero value add", Decimal{}.Add(b), "0.1"},
+        {"new decimal", NewDecimal(-1234, 2), "-12.34"},
+        {"new decimal negative scale", NewDecimal(5, -2), "500"},
+        {"new decimal negative scale arithmetic", NewDecimal(-3, -1).Add(b), "-29.9"},
+        {"rescale up", b.Rescale(3, RoundHalfUp), "0.100"},
+        {"rescale half even", MustParseDecimal("2.345").Rescale(2, RoundHalfEven), "2.34"},
+        {"rescale half up negative", MustParseDecimal("-2.345").Rescale(2, RoundHalfUp), "-2.35"},
+        {"rescale floor negative", MustParseDecimal("-2.341").Rescale(2, RoundFloor), "-2.35"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if tt.got.String() != tt.expected {
+                t.Errorf("got %v, want %v", tt.got, tt.expected)
+            }
+        })
+    }
+
+    t.Run("operands are not modified", func(t *testing.T) {
+        a.Add(b).Mul(b)
+        if a.String() != "10.25" || b.String() != "0.1" {
+            t.Errorf("operands changed to %v and %v", a, b)
+        }
+    })
+}
+
+func TestDecimalDiv(t *testing.T) {
+    tests := []struct {
+        name     string
+        a, b     string
+        scale    int
+        mode     RoundingMode
+        expected string
+    }{
+        {"exact", "10.00", "4", 2, RoundHalfUp, "2.50"},
+        {"repeating half up", "2", "3", 4, RoundHalfUp, "0.6667"},
+        {"repeating truncate", "2", "3", 4, RoundTruncate, "0.6666"},
+        {"negative", "-1", "8", 2, RoundHalfEven, "-0.12"},
+        {"negative half up", "-1", "8", 2, RoundHalfUp, "-0.13"},
+        {"divisor scale", "1", "0.003", 1, RoundHalfUp, "333.3"},
+        {"lower scale than operands", "1.2345", "1", 2, RoundCeiling, "1.24"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := MustParseDecimal(tt.a).Div(MustParseDecimal(tt.b), tt.scale, tt.mode)
+            if err != nil {
+                t.Fatalf("unexpected error: %v", err)
+            }
+            if got.String() != tt.expected {
+                t.Errorf("Div() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    if _, err := NewDecimal(1, 0).Div(Decimal{}, 2, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+        t.Errorf("Div() error = %v, want %v", err, ErrDivisionByZero)
+    }
+}
+
+func TestDecimalCompare(t *testing.T) {
+    tests := []struct {
+        a, b     string
+        expected int
+    }{
+        {"1.5", "1.50", 0},
+        {"1.49", "1.5", -1},
+        {"-1", "-2", 1},
+        {"0", "-0.00", 0},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+            a, b := MustParseDecimal(tt.a), MustParseDecimal(tt.b)
+            if got := a.Cmp(b); got != tt.expected {
+                t.Errorf("Cmp() = %v, want %v", got, tt.expected)
+            }
+            if got := a.Equal(b); got != (tt.expected == 0) {
+                t.Errorf("Equal() = %v, want %v", got, tt.expected == 0)
+            }
+        })
+    }
+}
+
+func TestDecimalFromFloat(t *testing.T) {
+    got, err := DecimalFromFloat(1.005, 2, RoundHalfUp)
+    if err != nil || got.String() != "1.01" {
+        t.Errorf("DecimalFromFloat() = %v, %v, want 1.01, nil", got, err)
+    }
+    if got := MustParseDecimal("-12.5").Float64(); got != -12.5 {
+        t.Errorf("Float64() = %v, want %v", got, -12.5)
+    }
+}
+
+func TestDecimalMarshaling(t *testing.T) {
+    type invoice struct {
+        Total Decimal  `json:"total"`
+        Tax   *Decimal `json:"tax"`
+    }
+
+    data, err := json.Marshal(invoice{Total: MustParseDecimal("19.90")})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if string(data) != `{"total":"19.90","tax":null}` {
+        t.Errorf("json.Marshal() = %s", data)
+    }
+
+    var decoded invoice
+    if err := json.Unmarshal([]byte(`{"total":12.345,"tax":"1.05"}`), &decoded); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if decoded.Total.String() != "12.345" || decoded.Tax.String() != "1.05" {
+        t.Errorf("json.Unmarshal() = %v, %v", decoded.Total, decoded.Tax)
+    }
+
+    if err := json.Unmarshal([]byte(`{"total":"abc"}`), &decoded); !errors.Is(err, ErrInvalidDecimal) {
+        t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrInvalidDecimal)
+    }
+
+    var d Decimal
+    if err := d.UnmarshalText([]byte("-3.20")); err != nil || d.String() != "-3.20" {
+        t.Errorf("UnmarshalText() = %v, %v", d, err)
+    }
+    if text, _ := d.MarshalText(); string(text) != "-3.20" {
+        t.Errorf("MarshalText() = %s", text)
+    }
+
+    for input, want := range map[string]string{"1e2": "100", "-1.25E-2": "-0.0125", "2.5e+1": "25"} {
+        if err := json.Unmarshal([]byte(input), &d); err != nil || d.String() != want {
+            t.Errorf("json.Unmarshal(%s) = %v, %v, want %s", input, d, err, want)
+        }
+    }
+}
+
+func TestDecimalAllocate(t *testing.T) {
+    tests := []struct {
+        name     string
+        amount   string
+        ratios   []int
+        expected []string
+    }{
+        {"even split with remainder", "100.00", []int{1, 1, 1}, []string{"33.34", "33.33", "33.33"}},
+        {"weighted split", "0.05", []int{3, 7}, []string{"0.02", "0.03"}},
+        {"largest remainder wins", "0.10", []int{1, 1, 2, 2}, []string{"0.02", "0.02", "0.03", "0.03"}},
+        {"zero ratio", "5.00", []int{0, 1, 1}, []string{"0.00", "2.50", "2.50"}},
+        {"negative amount", "-100.00", []int{1, 1, 1}, []string{"-33.34", "-33.33", "-33.33"}},
+        {"ratios overflowing int", "1.00", []int{math.MaxInt, math.MaxInt}, []string{"0.50", "0.50"}},
+        {"largest int ratio", "1.00", []int{math.MaxInt, 1}, []string{"1.00", "0.00"}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            amount := MustParseDecimal(tt.amount)
+            parts, err := amount.Allocate(tt.ratios)
+            if err != nil {
+                t.Fatalf("unexpected error: %v", err)
+            }
+            got := Map(parts, Decimal.String)
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("Allocate() = %v, want %v", got, tt.expected)
+            }
+            if !SumDecimal(parts).Equal(amount) {
+                t.Errorf("Allocate() parts sum to %v, want %v", SumDecimal(parts), amount)
+            }
+        })
+    }
+
+    for _, ratios := range [][]int{nil, {0, 0}, {1, -1, 2}, {math.MaxInt, math.MinInt, 1}} {
+        if _, err := NewDecimal(100, 2).Allocate(ratios); !errors.Is(err, ErrInvalidRatios) {
+            t.Errorf("Allocate(%v) error = %v, want %v", ratios, err, ErrInvalidRatios)
+        }
+    }
+}
+
+func TestSumAndAverageDecimal(t *testing.T) {
+    nums := Map([]string{"0.10", "0.20", "0.3"}, MustParseDecimal)
+
+    if got := SumDecimal(nums); got.String() != "0.60" {
+        t.Errorf("SumDecimal() = %v, want %v", got, "0.60")
+    }
+    if got := SumDecimal(nil); got.String() != "0" {
+        t.Errorf("SumDecimal() = %v, want %v", got, "0")
+    }
+
+    got, err := AverageDecimal(nums, 3, RoundHalfEven)
+    if err != nil || got.String() != "0.200" {
+        t.Errorf("AverageDecimal() = %v, %v, want 0.200, nil", got, err)
+    }
+    if _, err := AverageDecimal(nil, 2, RoundHalfUp); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("AverageDecimal() error = %v, want %v", err, ErrEmptySlice)
+    }
+}
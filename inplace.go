@@ -0,0 +1,101 @@
+package gohelpers
+
+// The functions in this file modify their input instead of allocating a new slice. Those that change the
+// length return the new slice header, which shares the input's backing array; always use the returned
+// slice and treat the old one as invalid, as with append. Elements left past the new length are zeroed
+// so that they do not keep pointers alive.
+
+// ReverseInPlace reverses the order of elements in slice
+func ReverseInPlace[T any](slice []T) {
+    for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+        slice[i], slice[j] = slice[j], slice[i]
+    }
+}
+
+// FilterInPlace keeps the elements for which f returns true, preserving their order, and returns the
+// shortened slice. It reuses slice's backing array and never allocates.
+func FilterInPlace[T any](slice []T, f func(T) bool) []T {
+    n := 0
+    for _, v := range slice {
+        if f(v) {
+            slice[n] = v
+            n++
+        }
+    }
+    clear(slice[n:])
+    return slice[:n]
+}
+
+// UniqueInPlace keeps the first occurrence of each element, preserving order, and returns the shortened
+// slice. It reuses slice's backing array but needs a set of seen elements; use CompactSorted on sorted
+// data to avoid that allocation.
+func UniqueInPlace[T comparable](slice []T) []T {
+    seen := make(map[T]bool, len(slice))
+    return FilterInPlace(slice, func(v T) bool {
+        if seen[v] {
+            return false
+        }
+        seen[v] = true
+        return true
+    })
+}
+
+// CompactSorted removes consecutive duplicates, which leaves each element once if slice is sorted, and
+// returns the shortened slice. It reuses slice's backing array and never allocates.
+func CompactSorted[T comparable](slice []T) []T {
+    if len(slice) == 0 {
+        return slice
+    }
+    n := 1
+    for _, v := range slice[1:] {
+        if v != slice[n-1] {
+            slice[n] = v
+            n++
+        }
+    }
+    clear(slice[n:])
+    return slice[:n]
+}
+
+// RotateInPlace moves every element k positions towards the front, wrapping around, so the result starts
+// with what was slice[k]. A negative k rotates towards the back.
+func RotateInPlace[T any](slice []T, k int) {
+    n := len(slice)
+    if n == 0 {
+        return
+    }
+    k %= n
+    if k < 0 {
+        k += n
+    }
+    // Rotating is reversing both halves and then the whole slice
+    ReverseInPlace(slice[:k])
+    ReverseInPlace(slice[k:])
+    ReverseInPlace(slice)
+}
+
+// RemoveAt removes the element at index i, shifting later elements down, and returns the shortened slice.
+// It reuses slice's backing array and never allocates.
+func RemoveAt[T any](slice []T, i int) ([]T, error) {
+    if i < 0 || i >= len(slice) {
+        return slice, ErrIndexOutOfRange
+    }
+    copy(slice[i:], slice[i+1:])
+    clear(slice[len(slice)-1:])
+    return slice[:len(slice)-1], nil
+}
+
+// InsertAt inserts values before index i (len(slice) appends) and returns the lengthened slice. Like
+// append, it reuses slice's backing array when there is spare capacity and allocates a new one otherwise,
+// so other slices sharing the array may or may not see the shifted elements. values must not share
+// slice's backing array.
+func InsertAt[T any](slice []T, i int, values ...T) ([]T, error) {
+    if i < 0 || i > len(slice) {
+        return slice, ErrIndexOutOfRange
+    }
+    n := len(slice)
+    slice = append(slice, values...)
+    copy(slice[i+len(values):], slice[i:n])
+    copy(slice[i:], values)
+    return slice, nil
+}
@@ -0,0 +1,283 @@
+package gohelpers
+
+import (
+    "errors"
+    "reflect"
+    "sort"
+    "testing"
+)
+
+func TestReverseInPlace(t *testing.T) {
+    tests := []struct {
+        name  string
+        slice []int
+    }{
+        {"empty", []int{}},
+        {"single", []int{1}},
+        {"even", []int{1, 2, 3, 4}},
+        {"odd", []int{1, 2, 3, 4, 5}},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            want := Reverse(tt.slice)
+            ReverseInPlace(tt.slice)
+            if !reflect.DeepEqual(tt.slice, want) {
+                t.Errorf("ReverseInPlace() = %v, want %v", tt.slice, want)
+            }
+        })
+    }
+}
+
+func TestFilterInPlace(t *testing.T) {
+    isEven := func(x int) bool { return x%2 == 0 }
+    slice := []int{1, 2, 3, 4, 5, 6}
+    backing := slice
+
+    got := FilterInPlace(slice, isEven)
+    if !reflect.DeepEqual(got, []int{2, 4, 6}) {
+        t.Errorf("FilterInPlace() = %v, want [2 4 6]", got)
+    }
+    if &got[0] != &backing[0] {
+        t.Errorf("FilterInPlace() did not reuse the backing array")
+    }
+    if !reflect.DeepEqual(backing[3:], []int{0, 0, 0}) {
+        t.Errorf("FilterInPlace() left %v past the new length, want zeros", backing[3:])
+    }
+
+    if got := FilterInPlace([]int{1, 3}, isEven); len(got) != 0 {
+        t.Errorf("FilterInPlace() = %v, want []", got)
+    }
+}
+
+func TestUniqueInPlace(t *testing.T) {
+    slice := []string{"b", "a", "b", "c", "a"}
+    got := UniqueInPlace(slice)
+    if !reflect.DeepEqual(got, []string{"b", "a", "c"}) {
+        t.Errorf("UniqueInPlace() = %v, want [b a c]", got)
+    }
+    if !reflect.DeepEqual(slice[3:], []string{"", ""}) {
+        t.Errorf("UniqueInPlace() left %q past the new length, want empty strings", slice[3:])
+    }
+}
+
+func TestCompactSorted(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []int
+        expected []int
+    }{
+        {"empty", []int{}, []int{}},
+        {"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+        {"runs", []int{1, 1, 2, 3, 3, 3, 4}, []int{1, 2, 3, 4}},
+        {"all equal", []int{5, 5, 5}, []int{5}},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := CompactSorted(tt.slice); !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("CompactSorted() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    // On sorted data it agrees with Unique
+    slice := []int{4, 1, 3, 1, 4, 4, 2}
+    want := Unique(slice)
+    sort.Ints(want)
+    sort.Ints(slice)
+    if got := CompactSorted(slice); !reflect.DeepEqual(got, want) {
+        t.Errorf("CompactSorted() = %v, want %v", got, want)
+    }
+}
+
+func TestRotateInPlace(t *testing.T) {
+    tests := []struct {
+        name     string
+        k        int
+        expected []int
+    }{
+        {"zero", 0, []int{1, 2, 3, 4, 5}},
+        {"left", 2, []int{3, 4, 5, 1, 2}},
+        {"right", -1, []int{5, 1, 2, 3, 4}},
+        {"full turn", 5, []int{1, 2, 3, 4, 5}},
+        {"more than length", 7, []int{3, 4, 5, 1, 2}},
+        {"negative more than length", -6, []int{5, 1, 2, 3, 4}},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            slice := []int{1, 2, 3, 4, 5}
+            RotateInPlace(slice, tt.k)
+            if !reflect.DeepEqual(slice, tt.expected) {
+                t.Errorf("RotateInPlace(%d) = %v, want %v", tt.k, slice, tt.expected)
+            }
+        })
+    }
+    RotateInPlace([]int{}, 3)
+}
+
+func TestRemoveAt(t *testing.T) {
+    slice := []int{1, 2, 3, 4}
+    backing := slice
+
+    got, err := RemoveAt(slice, 1)
+    if err != nil || !reflect.DeepEqual(got, []int{1, 3, 4}) {
+        t.Errorf("RemoveAt(1) = %v, %v, want [1 3 4]", got, err)
+    }
+    if backing[3] != 0 {
+        t.Errorf("RemoveAt() left %d past the new length, want 0", backing[3])
+    }
+    got, _ = RemoveAt(got, 2)
+    if !reflect.DeepEqual(got, []int{1, 3}) {
+        t.Errorf("RemoveAt(last) = %v, want [1 3]", got)
+    }
+
+    for _, i := range []int{-1, 2} {
+        if _, err := RemoveAt(got, i); !errors.Is(err, ErrIndexOutOfRange) {
+            t.Errorf("RemoveAt(%d) error = %v, want ErrIndexOutOfRange", i, err)
+        }
+    }
+}
+
+func TestInsertAt(t *testing.T) {
+    tests := []struct {
+        name     string
+        i        int
+        values   []int
+        expected []int
+    }{
+        {"front", 0, []int{9}, []int{9, 1, 2, 3}},
+        {"middle", 1, []int{8, 9}, []int{1, 8, 9, 2, 3}},
+        {"end", 3, []int{9}, []int{1, 2, 3, 9}},
+        {"nothing", 1, nil, []int{1, 2, 3}},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := InsertAt([]int{1, 2, 3}, tt.i, tt.values...)
+            if err != nil || !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("InsertAt(%d) = %v, %v, want %v", tt.i, got, err, tt.expected)
+            }
+        })
+    }
+
+    // With spare capacity the backing array is reused
+    slice := make([]int, 3, 10)
+    copy(slice, []int{1, 2, 3})
+    got, _ := InsertAt(slice, 1, 7)
+    if &got[0] != &slice[0] || !reflect.DeepEqual(got, []int{1, 7, 2, 3}) {
+        t.Errorf("InsertAt() = %v, want [1 7 2 3] in the same backing array", got)
+    }
+
+    for _, i := range []int{-1, 4} {
+        if _, err := InsertAt([]int{1, 2, 3}, i, 0); !errors.Is(err, ErrIndexOutOfRange) {
+            t.Errorf("InsertAt(%d) error = %v, want ErrIndexOutOfRange", i, err)
+        }
+    }
+}
+
+// benchmarkData is the shared benchmark input. Benchmarks that modify their input work on a reusable
+// buffer, refilled with copy (which does not allocate) when the operation changes the contents.
+var benchmarkData = ShuffleWith(append(Range(0, 5000), Range(0, 5000)...), NewSeededRand(1))
+
+func BenchmarkReverse(b *testing.B) {
+    b.Run("Reverse", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            Reverse(benchmarkData)
+        }
+    })
+    b.Run("ReverseInPlace", func(b *testing.B) {
+        buf := make([]int, len(benchmarkData))
+        copy(buf, benchmarkData)
+        b.ReportAllocs()
+        b.ResetTimer()
+        for i := 0; i < b.N; i++ {
+            ReverseInPlace(buf)
+        }
+    })
+}
+
+func BenchmarkFilter(b *testing.B) {
+    isEven := func(x int) bool { return x%2 == 0 }
+    buf := make([]int, len(benchmarkData))
+    b.Run("Filter", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(buf, benchmarkData)
+            Filter(buf, isEven)
+        }
+    })
+    b.Run("FilterInPlace", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(buf, benchmarkData)
+            FilterInPlace(buf, isEven)
+        }
+    })
+}
+
+func BenchmarkUnique(b *testing.B) {
+    buf := make([]int, len(benchmarkData))
+    sorted := append([]int(nil), benchmarkData...)
+    sort.Ints(sorted)
+    b.Run("Unique", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(buf, benchmarkData)
+            Unique(buf)
+        }
+    })
+    b.Run("UniqueInPlace", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(buf, benchmarkData)
+            UniqueInPlace(buf)
+        }
+    })
+    b.Run("CompactSorted", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            copy(buf, sorted)
+            CompactSorted(buf)
+        }
+    })
+}
+
+func BenchmarkShuffle(b *testing.B) {
+    r := NewSeededRand(1)
+    b.Run("ShuffleWith", func(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+            ShuffleWith(benchmarkData, r)
+        }
+    })
+    b.Run("ShuffleInPlaceWith", func(b *testing.B) {
+        buf := make([]int, len(benchmarkData))
+        copy(buf, benchmarkData)
+        b.ReportAllocs()
+        b.ResetTimer()
+        for i := 0; i < b.N; i++ {
+            ShuffleInPlaceWith(buf, r)
+        }
+    })
+}
+
+func BenchmarkRotateInPlace(b *testing.B) {
+    buf := make([]int, len(benchmarkData))
+    copy(buf, benchmarkData)
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        RotateInPlace(buf, 1234)
+    }
+}
+
+func BenchmarkRemoveInsertAt(b *testing.B) {
+    buf := make([]int, len(benchmarkData))
+    copy(buf, benchmarkData)
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        // Removing and reinserting keeps the length, so InsertAt always has spare capacity
+        buf, _ = RemoveAt(buf, 100)
+        buf, _ = InsertAt(buf, 100, i)
+    }
+}
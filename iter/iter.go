@@ -0,0 +1,221 @@
+// Package iter provides lazy versions of the gohelpers slice functions that work on iter.Seq and
+// iter.Seq2. Each operation pulls values only when they are needed, so pipelines run in constant
+// memory and stop as soon as the consumer stops ranging.
+package iter
+
+import (
+    "context"
+    "iter"
+)
+
+// FromSlice returns a sequence of the elements of a slice
+func FromSlice[T any](slice []T) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for _, v := range slice {
+            if !yield(v) {
+                return
+            }
+        }
+    }
+}
+
+// ToSlice collects every value of a sequence into a slice
+func ToSlice[T any](seq iter.Seq[T]) []T {
+    result := make([]T, 0)
+    for v := range seq {
+        result = append(result, v)
+    }
+    return result
+}
+
+// FromMap returns a sequence of the key-value pairs of a map, in unspecified order
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+    return func(yield func(K, V) bool) {
+        for k, v := range m {
+            if !yield(k, v) {
+                return
+            }
+        }
+    }
+}
+
+// ToMap collects every key-value pair of a sequence into a map; later pairs overwrite earlier ones
+func ToMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+    result := make(map[K]V)
+    for k, v := range seq {
+        result[k] = v
+    }
+    return result
+}
+
+// FromChan returns a sequence of the values received from a channel until it is closed
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for v := range ch {
+            if !yield(v) {
+                return
+            }
+        }
+    }
+}
+
+// ToChan sends every value of a sequence on the returned channel from a new goroutine and closes it
+// at the end. The goroutine stops early and closes the channel when ctx is cancelled.
+func ToChan[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+    out := make(chan T)
+    go func() {
+        defer close(out)
+        for v := range seq {
+            select {
+            case out <- v:
+            case <-ctx.Done():
+                return
+            }
+        }
+    }()
+    return out
+}
+
+// Map lazily applies a function to each value of a sequence
+func Map[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+    return func(yield func(U) bool) {
+        for v := range seq {
+            if !yield(f(v)) {
+                return
+            }
+        }
+    }
+}
+
+// Filter lazily yields the values of a sequence that pass the test
+func Filter[T any](seq iter.Seq[T], f func(T) bool) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for v := range seq {
+            if f(v) && !yield(v) {
+                return
+            }
+        }
+    }
+}
+
+// Take yields at most the first n values of a sequence
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        if n <= 0 {
+            return
+        }
+        taken := 0
+        for v := range seq {
+            if !yield(v) {
+                return
+            }
+            taken++
+            if taken == n {
+                return
+            }
+        }
+    }
+}
+
+// Skip yields every value of a sequence after the first n
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        skipped := 0
+        for v := range seq {
+            if skipped < n {
+                skipped++
+                continue
+            }
+            if !yield(v) {
+                return
+            }
+        }
+    }
+}
+
+// TakeWhile yields values of a sequence until the first one that fails the test
+func TakeWhile[T any](seq iter.Seq[T], f func(T) bool) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        for v := range seq {
+            if !f(v) || !yield(v) {
+                return
+            }
+        }
+    }
+}
+
+// Chunk groups the values of a sequence into slices of the given size; the last chunk may be shorter.
+// Each chunk is a new slice, so it can be kept after the next one is yielded.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+    return func(yield func([]T) bool) {
+        if size <= 0 {
+            return
+        }
+        chunk := make([]T, 0, size)
+        for v := range seq {
+            chunk = append(chunk, v)
+            if len(chunk) == size {
+                if !yield(chunk) {
+                    return
+                }
+                chunk = make([]T, 0, size)
+            }
+        }
+        if len(chunk) > 0 {
+            yield(chunk)
+        }
+    }
+}
+
+// Unique yields each distinct value of a sequence the first time it appears; memory grows with
+// the number of distinct values
+func Unique[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+    return func(yield func(T) bool) {
+        seen := make(map[T]bool)
+        for v := range seq {
+            if seen[v] {
+                continue
+            }
+            seen[v] = true
+            if !yield(v) {
+                return
+            }
+        }
+    }
+}
+
+// Zip pairs the values of two sequences and stops when either one ends
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+    return func(yield func(A, B) bool) {
+        next, stop := iter.Pull(b)
+        defer stop()
+        for va := range a {
+            vb, ok := next()
+            if !ok || !yield(va, vb) {
+                return
+            }
+        }
+    }
+}
+
+// Enumerate pairs each value of a sequence with its zero-based index
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+    return func(yield func(int, T) bool) {
+        i := 0
+        for v := range seq {
+            if !yield(i, v) {
+                return
+            }
+            i++
+        }
+    }
+}
+
+// Reduce reduces a sequence to a single value using a function
+func Reduce[T, U any](seq iter.Seq[T], initial U, f func(U, T) U) U {
+    result := initial
+    for v := range seq {
+        result = f(result, v)
+    }
+    return result
+}
@@ -0,0 +1,193 @@
+package iter
+
+import (
+    "context"
+    "iter"
+    "reflect"
+    "testing"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+// naturals is an infinite sequence, so any operation that is not lazy never returns
+func naturals() iter.Seq[int] {
+    return func(yield func(int) bool) {
+        for i := 0; ; i++ {
+            if !yield(i) {
+                return
+            }
+        }
+    }
+}
+
+func TestSliceAdapters(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []int
+        expected []int
+    }{
+        {"normal slice", []int{1, 2, 3}, []int{1, 2, 3}},
+        {"empty slice", []int{}, []int{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := ToSlice(FromSlice(tt.slice))
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("ToSlice(FromSlice()) = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestMapAdapters(t *testing.T) {
+    m := map[string]int{"a": 1, "b": 2}
+    if got := ToMap(FromMap(m)); !reflect.DeepEqual(got, m) {
+        t.Errorf("ToMap(FromMap()) = %v, want %v", got, m)
+    }
+    if got := ToMap(Enumerate(FromSlice([]string{"x", "y"}))); !reflect.DeepEqual(got, map[int]string{0: "x", 1: "y"}) {
+        t.Errorf("ToMap(Enumerate()) = %v", got)
+    }
+}
+
+func TestChanAdapters(t *testing.T) {
+    ch := ToChan(context.Background(), FromSlice([]int{1, 2, 3}))
+    if got := ToSlice(FromChan(ch)); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+        t.Errorf("FromChan(ToChan()) = %v", got)
+    }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    ch = ToChan(ctx, naturals())
+    if got := ToSlice(Take(FromChan(ch), 3)); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+        t.Errorf("Take(FromChan()) = %v", got)
+    }
+    cancel()
+    // After cancellation the producer closes the channel instead of blocking forever
+    for range ch {
+    }
+}
+
+func TestLazyOperations(t *testing.T) {
+    isEven := func(x int) bool { return x%2 == 0 }
+    square := func(x int) int { return x * x }
+
+    tests := []struct {
+        name     string
+        got      iter.Seq[int]
+        expected []int
+    }{
+        {"map", Map(FromSlice([]int{1, 2, 3}), square), []int{1, 4, 9}},
+        {"filter", Filter(FromSlice([]int{1, 2, 3, 4}), isEven), []int{2, 4}},
+        {"take from infinite", Take(naturals(), 4), []int{0, 1, 2, 3}},
+        {"take zero", Take(naturals(), 0), []int{}},
+        {"take more than available", Take(FromSlice([]int{1, 2}), 5), []int{1, 2}},
+        {"skip", Take(Skip(naturals(), 3), 2), []int{3, 4}},
+        {"skip everything", Skip(FromSlice([]int{1, 2}), 5), []int{}},
+        {"take while", TakeWhile(naturals(), func(x int) bool { return x < 3 }), []int{0, 1, 2}},
+        {"unique", Unique(FromSlice([]int{3, 1, 3, 2, 1})), []int{3, 1, 2}},
+        {"unique infinite", Take(Unique(Map(naturals(), func(x int) int { return x / 3 })), 3), []int{0, 1, 2}},
+        {"pipeline", Take(Filter(Map(naturals(), square), isEven), 3), []int{0, 4, 16}},
+        {"from RangeSeq", Filter(gohelpers.RangeSeq(0, 10, 3), isEven), []int{0, 6}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := ToSlice(tt.got); !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("got %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestMatchesSliceHelpers(t *testing.T) {
+    nums := []int{5, 3, 5, 8, 1, 3, 9, 2}
+    double := func(x int) int { return x * 2 }
+    odd := func(x int) bool { return x%2 == 1 }
+
+    if got := ToSlice(Map(FromSlice(nums), double)); !reflect.DeepEqual(got, gohelpers.Map(nums, double)) {
+        t.Errorf("Map() = %v, want %v", got, gohelpers.Map(nums, double))
+    }
+    if got := ToSlice(Filter(FromSlice(nums), odd)); !reflect.DeepEqual(got, gohelpers.Filter(nums, odd)) {
+        t.Errorf("Filter() = %v, want %v", got, gohelpers.Filter(nums, odd))
+    }
+    if got := ToSlice(Unique(FromSlice(nums))); !reflect.DeepEqual(got, gohelpers.Unique(nums)) {
+        t.Errorf("Unique() = %v, want %v", got, gohelpers.Unique(nums))
+    }
+    if got := ToSlice(Chunk(FromSlice(nums), 3)); !reflect.DeepEqual(got, gohelpers.Chunk(nums, 3)) {
+        t.Errorf("Chunk() = %v, want %v", got, gohelpers.Chunk(nums, 3))
+    }
+    sum := func(acc, x int) int { return acc + x }
+    if got := Reduce(FromSlice(nums), 0, sum); got != gohelpers.Sum(nums) {
+        t.Errorf("Reduce() = %v, want %v", got, gohelpers.Sum(nums))
+    }
+}
+
+func TestChunk(t *testing.T) {
+    chunks := ToSlice(Take(Chunk(naturals(), 2), 3))
+    if !reflect.DeepEqual(chunks, [][]int{{0, 1}, {2, 3}, {4, 5}}) {
+        t.Errorf("Chunk() = %v", chunks)
+    }
+    if got := ToSlice(Chunk(FromSlice([]int{1, 2}), 0)); len(got) != 0 {
+        t.Errorf("Chunk() with size 0 = %v, want none", got)
+    }
+}
+
+func TestZipAndEnumerate(t *testing.T) {
+    var letters []string
+    var numbers []int
+    for n, s := range Zip(naturals(), FromSlice([]string{"a", "b", "c"})) {
+        numbers = append(numbers, n)
+        letters = append(letters, s)
+    }
+    if !reflect.DeepEqual(numbers, []int{0, 1, 2}) || !reflect.DeepEqual(letters, []string{"a", "b", "c"}) {
+        t.Errorf("Zip() = %v, %v", numbers, letters)
+    }
+
+    pairs := 0
+    for a := range Zip(FromSlice([]int{1, 2, 3}), naturals()) {
+        if a == 2 {
+            break
+        }
+        pairs++
+    }
+    if pairs != 1 {
+        t.Errorf("Zip() yielded %d pairs before break, want 1", pairs)
+    }
+
+    var indices []int
+    for i, v := range Enumerate(FromSlice([]string{"x", "y", "z"})) {
+        if v == "z" {
+            break
+        }
+        indices = append(indices, i)
+    }
+    if !reflect.DeepEqual(indices, []int{0, 1}) {
+        t.Errorf("Enumerate() indices = %v, want [0 1]", indices)
+    }
+}
+
+func TestEarlyStopPullsOnlyWhatIsNeeded(t *testing.T) {
+    pulled := 0
+    counting := Map(naturals(), func(x int) int {
+        pulled++
+        return x
+    })
+    for range Take(Filter(counting, func(x int) bool { return x > 2 }), 2) {
+    }
+    if pulled != 5 {
+        t.Errorf("pipeline pulled %d values, want 5", pulled)
+    }
+}
+
+func TestFromMapStopsEarly(t *testing.T) {
+    keys := make([]string, 0)
+    for k := range FromMap(map[string]int{"a": 1, "b": 2, "c": 3}) {
+        keys = append(keys, k)
+        if len(keys) == 2 {
+            break
+        }
+    }
+    if len(keys) != 2 {
+        t.Errorf("FromMap() yielded %v after break", keys)
+    }
+}
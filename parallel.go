@@ -0,0 +1,156 @@
+package gohelpers
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "runtime"
+    "sync"
+    "sync/atomic"
+)
+
+// ErrorMode selects how helpers that run fallible callbacks react to errors
+type ErrorMode int
+
+const (
+    // StopOnError stops at the first error and returns it
+    StopOnError ErrorMode = iota
+    // CollectErrors processes every element and returns all errors joined with errors.Join,
+    // together with the partial results
+    CollectErrors
+)
+
+// IndexError records the index of the element whose callback failed
+type IndexError struct {
+    Index int
+    Err   error
+}
+
+func (e *IndexError) Error() string {
+    return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+    return e.Err
+}
+
+// parallelRun calls f for every index in [0, n) using at most limit goroutines (GOMAXPROCS if limit <= 0).
+// With StopOnError the first failure cancels the context passed to the remaining calls.
+func parallelRun(ctx context.Context, n, limit int, mode ErrorMode, f func(ctx context.Context, i int) error) error {
+    if limit <= 0 {
+        limit = runtime.GOMAXPROCS(0)
+    }
+    parent := ctx
+    ctx, cancel := context.WithCancel(parent)
+    defer cancel()
+
+    var (
+        next     atomic.Int64
+        done     atomic.Int64
+        wg       sync.WaitGroup
+        once     sync.Once
+        firstErr error
+        errs     = make([]error, n)
+    )
+    for w := 0; w < Min(limit, n); w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for {
+                i := int(next.Add(1) - 1)
+                if i >= n || ctx.Err() != nil {
+                    return
+                }
+                if err := f(ctx, i); err != nil {
+                    errs[i] = &IndexError{Index: i, Err: err}
+                    if mode == StopOnError {
+                        once.Do(func() {
+                            firstErr = errs[i]
+                            cancel()
+                        })
+                    }
+                }
+                done.Add(1)
+            }
+        }()
+    }
+    wg.Wait()
+
+    if firstErr != nil {
+        return firstErr
+    }
+    err := errors.Join(errs...)
+    if done.Load() < int64(n) {
+        // Only the caller's context can stop the workers early without an error of their own
+        err = errors.Join(err, parent.Err())
+    }
+    return err
+}
+
+// ParallelMap applies f to every element using at most limit goroutines and returns the results in input order.
+// With StopOnError the first error cancels the remaining calls and the results are nil; with CollectErrors
+// failed elements hold the zero value.
+func ParallelMap[T, U any](ctx context.Context, slice []T, limit int, mode ErrorMode, f func(context.Context, T) (U, error)) ([]U, error) {
+    result := make([]U, len(slice))
+    err := parallelRun(ctx, len(slice), limit, mode, func(ctx context.Context, i int) error {
+        v, err := f(ctx, slice[i])
+        if err != nil {
+            return err
+        }
+        result[i] = v
+        return nil
+    })
+    if err != nil && mode == StopOnError {
+        return nil, err
+    }
+    return result, err
+}
+
+// ParallelFilter keeps the elements that pass the test, checking them with at most limit goroutines and
+// preserving input order. Errors are handled as in ParallelMap; with CollectErrors failed elements are dropped.
+func ParallelFilter[T any](ctx context.Context, slice []T, limit int, mode ErrorMode, f func(context.Context, T) (bool, error)) ([]T, error) {
+    keep, err := ParallelMap(ctx, slice, limit, mode, f)
+    if err != nil && mode == StopOnError {
+        return nil, err
+    }
+    result := make([]T, 0)
+    for i, ok := range keep {
+        if ok {
+            result = append(result, slice[i])
+        }
+    }
+    return result, err
+}
+
+// ParallelForEach calls f for every element using at most limit goroutines. Errors are handled as in ParallelMap.
+func ParallelForEach[T any](ctx context.Context, slice []T, limit int, mode ErrorMode, f func(context.Context, T) error) error {
+    return parallelRun(ctx, len(slice), limit, mode, func(ctx context.Context, i int) error {
+        return f(ctx, slice[i])
+    })
+}
+
+// ParallelReduce combines the elements with an associative function in a balanced tree, running each
+// level with at most limit goroutines. The function need not be commutative; the element order is kept.
+func ParallelReduce[T any](ctx context.Context, slice []T, limit int, f func(a, b T) T) (T, error) {
+    var zero T
+    if len(slice) == 0 {
+        return zero, ErrEmptySlice
+    }
+    level := slice
+    for len(level) > 1 {
+        next := make([]T, (len(level)+1)/2)
+        err := parallelRun(ctx, len(next), limit, StopOnError, func(_ context.Context, i int) error {
+            if 2*i+1 < len(level) {
+                next[i] = f(level[2*i], level[2*i+1])
+            } else {
+                next[i] = level[2*i]
+            }
+            return nil
+        })
+        if err != nil {
+            return zero, err
+        }
+        level = next
+    }
+    return level[0], nil
+}
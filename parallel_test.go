@@ -0,0 +1,168 @@
+package gohelpers
+
+import (
+    "context"
+    "errors"
+    "reflect"
+    "strconv"
+    "strings"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+var errOdd = errors.New("odd value")
+
+func TestParallelMap(t *testing.T) {
+    nums := Range(0, 100)
+    var inFlight, peak atomic.Int32
+
+    got, err := ParallelMap(context.Background(), nums, 4, StopOnError, func(_ context.Context, x int) (string, error) {
+        n := inFlight.Add(1)
+        defer inFlight.Add(-1)
+        for {
+            p := peak.Load()
+            if n <= p || peak.CompareAndSwap(p, n) {
+                break
+            }
+        }
+        time.Sleep(time.Duration(x%3) * time.Millisecond)
+        return strconv.Itoa(x), nil
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(got, Map(nums, strconv.Itoa)) {
+        t.Errorf("ParallelMap() did not preserve order: %v", got)
+    }
+    if peak.Load() > 4 {
+        t.Errorf("ParallelMap() ran %d calls at once, limit was 4", peak.Load())
+    }
+
+    if got, err := ParallelMap(context.Background(), []int{}, 0, StopOnError, func(_ context.Context, x int) (int, error) {
+        return x, nil
+    }); err != nil || len(got) != 0 {
+        t.Errorf("ParallelMap() on empty slice = %v, %v", got, err)
+    }
+}
+
+func TestParallelMapStopOnError(t *testing.T) {
+    var cancelled atomic.Int32
+    nums := Range(0, 50)
+
+    got, err := ParallelMap(context.Background(), nums, 4, StopOnError, func(ctx context.Context, x int) (int, error) {
+        if x == 3 {
+            return 0, errOdd
+        }
+        select {
+        case <-ctx.Done():
+            cancelled.Add(1)
+            return 0, ctx.Err()
+        case <-time.After(20 * time.Millisecond):
+            return x, nil
+        }
+    })
+
+    var indexErr *IndexError
+    if !errors.Is(err, errOdd) || !errors.As(err, &indexErr) || indexErr.Index != 3 {
+        t.Fatalf("ParallelMap() error = %v, want errOdd at index 3", err)
+    }
+    if got != nil {
+        t.Errorf("ParallelMap() results = %v, want nil", got)
+    }
+    if cancelled.Load() == 0 {
+        t.Errorf("ParallelMap() did not cancel the calls in flight")
+    }
+}
+
+func TestParallelMapCollectErrors(t *testing.T) {
+    got, err := ParallelMap(context.Background(), []int{1, 2, 3, 4}, 2, CollectErrors, func(_ context.Context, x int) (int, error) {
+        if x%2 == 1 {
+            return 0, errOdd
+        }
+        return x * 10, nil
+    })
+    if !reflect.DeepEqual(got, []int{0, 20, 0, 40}) {
+        t.Errorf("ParallelMap() = %v, want [0 20 0 40]", got)
+    }
+    if !errors.Is(err, errOdd) {
+        t.Fatalf("ParallelMap() error = %v, want errOdd", err)
+    }
+    if msg := err.Error(); msg != "index 0: odd value\nindex 2: odd value" {
+        t.Errorf("ParallelMap() error message = %q", msg)
+    }
+}
+
+func TestParallelFilter(t *testing.T) {
+    nums := Range(0, 30)
+    isEven := func(x int) bool { return x%2 == 0 }
+    got, err := ParallelFilter(context.Background(), nums, 3, StopOnError, func(_ context.Context, x int) (bool, error) {
+        return isEven(x), nil
+    })
+    if err != nil || !reflect.DeepEqual(got, Filter(nums, isEven)) {
+        t.Errorf("ParallelFilter() = %v, %v", got, err)
+    }
+
+    got, err = ParallelFilter(context.Background(), []int{1, 2, 3, 4}, 2, CollectErrors, func(_ context.Context, x int) (bool, error) {
+        if x == 3 {
+            return true, errOdd
+        }
+        return true, nil
+    })
+    if !errors.Is(err, errOdd) || !reflect.DeepEqual(got, []int{1, 2, 4}) {
+        t.Errorf("ParallelFilter() = %v, %v, want [1 2 4] and errOdd", got, err)
+    }
+}
+
+func TestParallelForEach(t *testing.T) {
+    var total atomic.Int64
+    err := ParallelForEach(context.Background(), Range(1, 101), 8, StopOnError, func(_ context.Context, x int) error {
+        total.Add(int64(x))
+        return nil
+    })
+    if err != nil || total.Load() != 5050 {
+        t.Errorf("ParallelForEach() total = %v, %v, want 5050", total.Load(), err)
+    }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    var calls atomic.Int32
+    err = ParallelForEach(ctx, Range(0, 1000), 2, CollectErrors, func(_ context.Context, x int) error {
+        if calls.Add(1) == 10 {
+            cancel()
+        }
+        return nil
+    })
+    if !errors.Is(err, context.Canceled) {
+        t.Errorf("ParallelForEach() error = %v, want %v", err, context.Canceled)
+    }
+    if calls.Load() >= 1000 {
+        t.Errorf("ParallelForEach() kept running after cancellation")
+    }
+}
+
+func TestParallelReduce(t *testing.T) {
+    nums := Range(1, 1001)
+    got, err := ParallelReduce(context.Background(), nums, 4, func(a, b int) int { return a + b })
+    if err != nil || got != Sum(nums) {
+        t.Errorf("ParallelReduce() = %v, %v, want %v", got, err, Sum(nums))
+    }
+
+    words := strings.Split("the quick brown fox jumps", " ")
+    joined, err := ParallelReduce(context.Background(), words, 0, func(a, b string) string { return a + " " + b })
+    if err != nil || joined != "the quick brown fox jumps" {
+        t.Errorf("ParallelReduce() = %q, %v, want order preserved", joined, err)
+    }
+
+    if got, _ := ParallelReduce(context.Background(), []int{7}, 2, func(a, b int) int { return a + b }); got != 7 {
+        t.Errorf("ParallelReduce() single element = %v, want 7", got)
+    }
+    if _, err := ParallelReduce(context.Background(), []int{}, 2, func(a, b int) int { return a + b }); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("ParallelReduce() error = %v, want %v", err, ErrEmptySlice)
+    }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    if _, err := ParallelReduce(ctx, nums, 2, func(a, b int) int { return a + b }); !errors.Is(err, context.Canceled) {
+        t.Errorf("ParallelReduce() error = %v, want %v", err, context.Canceled)
+    }
+}
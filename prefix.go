@@ -0,0 +1,128 @@
+package gohelpers
+
+import "errors"
+
+// ErrIndexOutOfRange is returned when an index or range falls outside a data structure
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// cumulative returns the running result of combining each element with everything before it
+func cumulative[T Number](nums []T, combine func(acc, x T) T) []T {
+    result := make([]T, len(nums))
+    for i, num := range nums {
+        if i == 0 {
+            result[i] = num
+        } else {
+            result[i] = combine(result[i-1], num)
+        }
+    }
+    return result
+}
+
+// CumSum returns the running sum of a slice
+func CumSum[T Number](nums []T) []T {
+    return cumulative(nums, func(acc, x T) T { return acc + x })
+}
+
+// CumProd returns the running product of a slice
+func CumProd[T Number](nums []T) []T {
+    return cumulative(nums, func(acc, x T) T { return acc * x })
+}
+
+// CumMax returns the running maximum of a slice
+func CumMax[T Number](nums []T) []T {
+    return cumulative(nums, Max[T])
+}
+
+// CumMin returns the running minimum of a slice
+func CumMin[T Number](nums []T) []T {
+    return cumulative(nums, Min[T])
+}
+
+// PrefixSum answers range-sum queries over a static slice in O(1) after O(n) preprocessing
+type PrefixSum[T Number] struct {
+    // sums[i] is the sum of the first i elements
+    sums []T
+}
+
+// NewPrefixSum builds a PrefixSum over nums; later changes to nums are not reflected
+func NewPrefixSum[T Number](nums []T) *PrefixSum[T] {
+    return &PrefixSum[T]{sums: append([]T{0}, CumSum(nums)...)}
+}
+
+// Len returns the number of elements covered
+func (p *PrefixSum[T]) Len() int {
+    return len(p.sums) - 1
+}
+
+// RangeSum returns the sum of the elements from start to end (exclusive)
+func (p *PrefixSum[T]) RangeSum(start, end int) (T, error) {
+    if start < 0 || end > p.Len() || start > end {
+        return 0, ErrIndexOutOfRange
+    }
+    return p.sums[end] - p.sums[start], nil
+}
+
+// Fenwick is a Fenwick (binary indexed) tree supporting point updates and range sums in O(log n)
+type Fenwick[T Number] struct {
+    // tree is 1-based: tree[i] holds the sum of the i&-i elements ending at position i
+    tree []T
+}
+
+// NewFenwick returns a Fenwick tree of n zeros
+func NewFenwick[T Number](n int) *Fenwick[T] {
+    return &Fenwick[T]{tree: make([]T, Max(n, 0)+1)}
+}
+
+// NewFenwickFrom builds a Fenwick tree over a copy of nums in O(n)
+func NewFenwickFrom[T Number](nums []T) *Fenwick[T] {
+    f := &Fenwick[T]{tree: make([]T, len(nums)+1)}
+    copy(f.tree[1:], nums)
+    for i := 1; i < len(f.tree); i++ {
+        if parent := i + i&-i; parent < len(f.tree) {
+            f.tree[parent] += f.tree[i]
+        }
+    }
+    return f
+}
+
+// Len returns the number of elements in the tree
+func (f *Fenwick[T]) Len() int {
+    return len(f.tree) - 1
+}
+
+// Add adds delta to the element at index
+func (f *Fenwick[T]) Add(index int, delta T) error {
+    if index < 0 || index >= f.Len() {
+        return ErrIndexOutOfRange
+    }
+    for i := index + 1; i < len(f.tree); i += i & -i {
+        f.tree[i] += delta
+    }
+    return nil
+}
+
+// Set replaces the element at index with value
+func (f *Fenwick[T]) Set(index int, value T) error {
+    current, err := f.RangeSum(index, index+1)
+    if err != nil {
+        return err
+    }
+    return f.Add(index, value-current)
+}
+
+// prefix returns the sum of the first n elements
+func (f *Fenwick[T]) prefix(n int) T {
+    var sum T
+    for i := n; i > 0; i -= i & -i {
+        sum += f.tree[i]
+    }
+    return sum
+}
+
+// RangeSum returns the sum of the elements from start to end (exclusive)
+func (f *Fenwick[T]) RangeSum(start, end int) (T, error) {
+    if start < 0 || end > f.Len() || start > end {
+        return 0, ErrIndexOutOfRange
+    }
+    return f.prefix(end) - f.prefix(start), nil
+}
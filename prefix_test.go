@@ -0,0 +1,107 @@
+package gohelpers
+
+import (
+    "errors"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+func TestCumulative(t *testing.T) {
+    nums := []int{3, 1, 4, 1, 5}
+
+    tests := []struct {
+        name     string
+        got      []int
+        expected []int
+    }{
+        {"CumSum", CumSum(nums), []int{3, 4, 8, 9, 14}},
+        {"CumProd", CumProd(nums), []int{3, 3, 12, 12, 60}},
+        {"CumMax", CumMax(nums), []int{3, 3, 4, 4, 5}},
+        {"CumMin", CumMin(nums), []int{3, 1, 1, 1, 1}},
+        {"empty slice", CumSum([]int{}), []int{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if !reflect.DeepEqual(tt.got, tt.expected) {
+                t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestPrefixSum(t *testing.T) {
+    nums := []int{3, 1, 4, 1, 5, 9, 2, 6}
+    p := NewPrefixSum(nums)
+
+    for start := 0; start <= len(nums); start++ {
+        for end := start; end <= len(nums); end++ {
+            got, err := p.RangeSum(start, end)
+            if err != nil || got != Sum(nums[start:end]) {
+                t.Errorf("RangeSum(%d, %d) = %v, %v, want %v", start, end, got, err, Sum(nums[start:end]))
+            }
+        }
+    }
+
+    for _, r := range [][2]int{{-1, 2}, {0, 9}, {5, 4}} {
+        if _, err := p.RangeSum(r[0], r[1]); !errors.Is(err, ErrIndexOutOfRange) {
+            t.Errorf("RangeSum(%d, %d) error = %v, want %v", r[0], r[1], err, ErrIndexOutOfRange)
+        }
+    }
+    if p.Len() != len(nums) {
+        t.Errorf("Len() = %v, want %v", p.Len(), len(nums))
+    }
+}
+
+func TestFenwick(t *testing.T) {
+    r := rand.New(rand.NewSource(11))
+    nums := make([]int64, 200)
+    for i := range nums {
+        nums[i] = r.Int63n(1000) - 500
+    }
+    f := NewFenwickFrom(nums)
+
+    for step := 0; step < 1000; step++ {
+        i := r.Intn(len(nums))
+        if step%2 == 0 {
+            delta := r.Int63n(100) - 50
+            nums[i] += delta
+            if err := f.Add(i, delta); err != nil {
+                t.Fatalf("Add() unexpected error: %v", err)
+            }
+        } else {
+            nums[i] = r.Int63n(100)
+            if err := f.Set(i, nums[i]); err != nil {
+                t.Fatalf("Set() unexpected error: %v", err)
+            }
+        }
+
+        start := r.Intn(len(nums))
+        end := start + r.Intn(len(nums)-start+1)
+        got, err := f.RangeSum(start, end)
+        if err != nil || got != Sum(nums[start:end]) {
+            t.Fatalf("RangeSum(%d, %d) = %v, %v, want %v", start, end, got, err, Sum(nums[start:end]))
+        }
+    }
+}
+
+func TestFenwickErrors(t *testing.T) {
+    f := NewFenwick[float64](3)
+    if f.Len() != 3 {
+        t.Errorf("Len() = %v, want 3", f.Len())
+    }
+    if err := f.Add(3, 1); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("Add() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    if err := f.Set(-1, 1); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("Set() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    if _, err := f.RangeSum(2, 1); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("RangeSum() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    f.Set(1, 2.5)
+    if got, _ := f.RangeSum(0, 3); got != 2.5 {
+        t.Errorf("RangeSum() = %v, want 2.5", got)
+    }
+}
@@ -0,0 +1,74 @@
+package gohelpers
+
+import (
+    "iter"
+    "math"
+)
+
+// rangeCount returns how many values a Python-style range from start towards stop yields with the
+// given step, computing the span in uint64 so ranges near the limits of T cannot overflow
+func rangeCount[T Integer](start, stop, step T) uint64 {
+    switch {
+    case step > 0 && start < stop:
+        return (uint64(stop)-uint64(start)-1)/uint64(step) + 1
+    case step < 0 && start > stop:
+        // Negate in uint64, since -step overflows when step is the minimum value of T
+        return (uint64(start)-uint64(stop)-1)/(uint64(0)-uint64(step)) + 1
+    default:
+        return 0
+    }
+}
+
+// RangeStep creates a slice of integers from start to stop (exclusive) in increments of step, like
+// Python's range; a negative step counts down and a zero step gives an empty slice
+func RangeStep[T Integer](start, stop, step T) []T {
+    result := make([]T, rangeCount(start, stop, step))
+    for i := range result {
+        result[i] = start + T(i)*step
+    }
+    return result
+}
+
+// RangeSeq lazily yields the integers RangeStep would return without allocating a slice
+func RangeSeq(start, stop, step int) iter.Seq[int] {
+    return func(yield func(int) bool) {
+        n := rangeCount(start, stop, step)
+        for i := uint64(0); i < n; i++ {
+            if !yield(start + int(i)*step) {
+                return
+            }
+        }
+    }
+}
+
+// Arange creates a slice of floats from start to stop (exclusive) in increments of step, like NumPy's arange.
+// Each value is computed as start + i*step, so rounding errors do not accumulate.
+func Arange[T Float](start, stop, step T) []T {
+    span := float64(stop-start) / float64(step)
+    if !(span > 0) || math.IsInf(span, 0) {
+        return []T{}
+    }
+    result := make([]T, int(math.Ceil(span)))
+    for i := range result {
+        result[i] = start + T(i)*step
+    }
+    return result
+}
+
+// Linspace creates a slice of n evenly spaced floats from start to stop (inclusive), like NumPy's linspace
+func Linspace[T Float](start, stop T, n int) []T {
+    if n <= 0 {
+        return []T{}
+    }
+    result := make([]T, n)
+    result[0] = start
+    if n == 1 {
+        return result
+    }
+    step := (stop - start) / T(n-1)
+    for i := 1; i < n-1; i++ {
+        result[i] = start + T(i)*step
+    }
+    result[n-1] = stop
+    return result
+}
@@ -0,0 +1,147 @@
+package gohelpers
+
+import (
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestRangeStep(t *testing.T) {
+    tests := []struct {
+        name              string
+        start, stop, step int
+        expected          []int
+    }{
+        {"step one matches Range", 0, 5, 1, []int{0, 1, 2, 3, 4}},
+        {"step three", 0, 10, 3, []int{0, 3, 6, 9}},
+        {"negative step", 10, 0, -3, []int{10, 7, 4, 1}},
+        {"negative step to negative", 2, -3, -2, []int{2, 0, -2}},
+        {"wrong direction", 0, 5, -1, []int{}},
+        {"negative step wrong direction", 5, 0, 1, []int{}},
+        {"empty range", 3, 3, 1, []int{}},
+        {"zero step", 0, 5, 0, []int{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := RangeStep(tt.start, tt.stop, tt.step)
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("RangeStep() = %v, want %v", got, tt.expected)
+            }
+            seq := make([]int, 0)
+            for i := range RangeSeq(tt.start, tt.stop, tt.step) {
+                seq = append(seq, i)
+            }
+            if !reflect.DeepEqual(seq, tt.expected) {
+                t.Errorf("RangeSeq() = %v, want %v", seq, tt.expected)
+            }
+        })
+    }
+
+    t.Run("other integer kinds", func(t *testing.T) {
+        if got := RangeStep[uint8](250, 255, 2); !reflect.DeepEqual(got, []uint8{250, 252, 254}) {
+            t.Errorf("RangeStep() = %v", got)
+        }
+        if got := RangeStep[int8](-128, 127, 127); !reflect.DeepEqual(got, []int8{-128, -1, 126}) {
+            t.Errorf("RangeStep() = %v", got)
+        }
+        if got := RangeStep[int8](127, -128, -128); !reflect.DeepEqual(got, []int8{127, -1}) {
+            t.Errorf("RangeStep() with the minimum int8 step = %v, want [127 -1]", got)
+        }
+        if got := RangeStep[int32](0, math.MinInt32, math.MinInt32); !reflect.DeepEqual(got, []int32{0}) {
+            t.Errorf("RangeStep() with the minimum int32 step = %v, want [0]", got)
+        }
+        if got := RangeStep[int64](math.MaxInt64-2, math.MinInt64, math.MinInt64); !reflect.DeepEqual(got, []int64{math.MaxInt64 - 2, -3}) {
+            t.Errorf("RangeStep() = %v", got)
+        }
+    })
+}
+
+func TestRangeSeq(t *testing.T) {
+    t.Run("stops early", func(t *testing.T) {
+        count := 0
+        for i := range RangeSeq(0, 1e9, 3) {
+            if i >= 30 {
+                break
+            }
+            count++
+        }
+        if count != 10 {
+            t.Errorf("RangeSeq() yielded %d values before break, want 10", count)
+        }
+    })
+
+    t.Run("near max int", func(t *testing.T) {
+        var got []int
+        for i := range RangeSeq(math.MaxInt-5, math.MaxInt, 2) {
+            got = append(got, i)
+        }
+        if !reflect.DeepEqual(got, []int{math.MaxInt - 5, math.MaxInt - 3, math.MaxInt - 1}) {
+            t.Errorf("RangeSeq() = %v", got)
+        }
+    })
+
+    t.Run("does not allocate", func(t *testing.T) {
+        allocs := testing.AllocsPerRun(10, func() {
+            sum := 0
+            for i := range RangeSeq(0, 10000, 7) {
+                sum += i
+            }
+        })
+        if allocs != 0 {
+            t.Errorf("RangeSeq() allocated %v times, want 0", allocs)
+        }
+    })
+}
+
+func TestArange(t *testing.T) {
+    tests := []struct {
+        name              string
+        start, stop, step float64
+        expected          []float64
+    }{
+        {"quarter steps", 0, 1, 0.25, []float64{0, 0.25, 0.5, 0.75}},
+        {"non-dividing step", 0, 1, 0.3, []float64{0, 0.3, 0.6, 0.8999999999999999}},
+        {"negative step", 1, 0, -0.5, []float64{1, 0.5}},
+        {"wrong direction", 0, 1, -0.5, []float64{}},
+        {"zero step", 0, 1, 0, []float64{}},
+        {"NaN step", 0, 1, math.NaN(), []float64{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := Arange(tt.start, tt.stop, tt.step)
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("Arange() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestLinspace(t *testing.T) {
+    tests := []struct {
+        name        string
+        start, stop float64
+        n           int
+        expected    []float64
+    }{
+        {"five points", 0, 1, 5, []float64{0, 0.25, 0.5, 0.75, 1}},
+        {"descending", 10, 0, 3, []float64{10, 5, 0}},
+        {"ends exactly at stop", 0, 0.3, 4, []float64{0, 0.09999999999999999, 0.19999999999999998, 0.3}},
+        {"single point", 2, 5, 1, []float64{2}},
+        {"zero points", 2, 5, 0, []float64{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := Linspace(tt.start, tt.stop, tt.n)
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("Linspace() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    if got := Linspace[float32](0, 1, 3); !reflect.DeepEqual(got, []float32{0, 0.5, 1}) {
+        t.Errorf("Linspace[float32]() = %v", got)
+    }
+}
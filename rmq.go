@@ -0,0 +1,207 @@
+package gohelpers
+
+import (
+    "cmp"
+    "math/bits"
+)
+
+// SparseTable answers range queries over static data in O(1) after O(n log n) preprocessing.
+// The combine function must be idempotent (combine(x, x) == x), as min, max and gcd are.
+type SparseTable[T any] struct {
+    // table[k][i] combines the 2^k elements starting at i
+    table   [][]T
+    combine func(a, b T) T
+}
+
+// NewSparseTable builds a SparseTable over a copy of nums using an idempotent combine function
+func NewSparseTable[T any](nums []T, combine func(a, b T) T) *SparseTable[T] {
+    s := &SparseTable[T]{table: [][]T{append([]T(nil), nums...)}, combine: combine}
+    for k := 1; 1<<k <= len(nums); k++ {
+        prev := s.table[k-1]
+        half := 1 << (k - 1)
+        row := make([]T, len(nums)-1<<k+1)
+        for i := range row {
+            row[i] = combine(prev[i], prev[i+half])
+        }
+        s.table = append(s.table, row)
+    }
+    return s
+}
+
+// NewMinSparseTable builds a SparseTable answering range-minimum queries
+func NewMinSparseTable[T cmp.Ordered](nums []T) *SparseTable[T] {
+    return NewSparseTable(nums, func(a, b T) T { return min(a, b) })
+}
+
+// NewMaxSparseTable builds a SparseTable answering range-maximum queries
+func NewMaxSparseTable[T cmp.Ordered](nums []T) *SparseTable[T] {
+    return NewSparseTable(nums, func(a, b T) T { return max(a, b) })
+}
+
+// Len returns the number of elements covered
+func (s *SparseTable[T]) Len() int {
+    return len(s.table[0])
+}
+
+// Query combines the elements from start to end (exclusive) by overlapping two power-of-two blocks
+func (s *SparseTable[T]) Query(start, end int) (T, error) {
+    var zero T
+    if start < 0 || end > s.Len() || start > end {
+        return zero, ErrIndexOutOfRange
+    }
+    if start == end {
+        return zero, ErrEmptySlice
+    }
+    k := bits.Len(uint(end-start)) - 1
+    return s.combine(s.table[k][start], s.table[k][end-1<<k]), nil
+}
+
+// SegmentOps describes how a SegmentTree combines values of type T and applies lazy range updates of type U
+type SegmentOps[T, U any] struct {
+    // Identity is the neutral value for Combine, returned for empty ranges
+    Identity T
+    // Combine merges the values of two adjacent ranges and must be associative
+    Combine func(a, b T) T
+    // Apply returns the value of a range of the given length after update is applied to all of it
+    Apply func(value T, update U, length int) T
+    // Compose returns a single update equivalent to applying older and then newer
+    Compose func(older, newer U) U
+}
+
+// SegmentTree supports range queries and lazy range updates over mutable data in O(log n)
+type SegmentTree[T, U any] struct {
+    n       int
+    ops     SegmentOps[T, U]
+    tree    []T
+    lazy    []U
+    pending []bool
+}
+
+// NewSegmentTree builds a SegmentTree over a copy of nums in O(n)
+func NewSegmentTree[T, U any](nums []T, ops SegmentOps[T, U]) *SegmentTree[T, U] {
+    size := 4 * Max(len(nums), 1)
+    st := &SegmentTree[T, U]{
+        n:       len(nums),
+        ops:     ops,
+        tree:    make([]T, size),
+        lazy:    make([]U, size),
+        pending: make([]bool, size),
+    }
+    if len(nums) > 0 {
+        st.build(nums, 1, 0, len(nums))
+    }
+    return st
+}
+
+func (st *SegmentTree[T, U]) build(nums []T, node, lo, hi int) {
+    if hi-lo == 1 {
+        st.tree[node] = nums[lo]
+        return
+    }
+    mid := (lo + hi) / 2
+    st.build(nums, 2*node, lo, mid)
+    st.build(nums, 2*node+1, mid, hi)
+    st.tree[node] = st.ops.Combine(st.tree[2*node], st.tree[2*node+1])
+}
+
+// applyNode applies update to the node covering [lo, hi) and records it for the node's children
+func (st *SegmentTree[T, U]) applyNode(node, lo, hi int, update U) {
+    st.tree[node] = st.ops.Apply(st.tree[node], update, hi-lo)
+    if hi-lo > 1 {
+        if st.pending[node] {
+            st.lazy[node] = st.ops.Compose(st.lazy[node], update)
+        } else {
+            st.lazy[node], st.pending[node] = update, true
+        }
+    }
+}
+
+// push hands a node's pending update down to its children
+func (st *SegmentTree[T, U]) push(node, lo, hi int) {
+    if !st.pending[node] {
+        return
+    }
+    mid := (lo + hi) / 2
+    st.applyNode(2*node, lo, mid, st.lazy[node])
+    st.applyNode(2*node+1, mid, hi, st.lazy[node])
+    var zero U
+    st.lazy[node], st.pending[node] = zero, false
+}
+
+// Len returns the number of elements in the tree
+func (st *SegmentTree[T, U]) Len() int {
+    return st.n
+}
+
+// Query combines the elements from start to end (exclusive); an empty range returns Identity
+func (st *SegmentTree[T, U]) Query(start, end int) (T, error) {
+    if start < 0 || end > st.n || start > end {
+        return st.ops.Identity, ErrIndexOutOfRange
+    }
+    if start == end {
+        return st.ops.Identity, nil
+    }
+    return st.query(1, 0, st.n, start, end), nil
+}
+
+func (st *SegmentTree[T, U]) query(node, lo, hi, start, end int) T {
+    if end <= lo || hi <= start {
+        return st.ops.Identity
+    }
+    if start <= lo && hi <= end {
+        return st.tree[node]
+    }
+    st.push(node, lo, hi)
+    mid := (lo + hi) / 2
+    return st.ops.Combine(st.query(2*node, lo, mid, start, end), st.query(2*node+1, mid, hi, start, end))
+}
+
+// Update applies update to every element from start to end (exclusive)
+func (st *SegmentTree[T, U]) Update(start, end int, update U) error {
+    if start < 0 || end > st.n || start > end {
+        return ErrIndexOutOfRange
+    }
+    if start < end {
+        st.update(1, 0, st.n, start, end, update)
+    }
+    return nil
+}
+
+func (st *SegmentTree[T, U]) update(node, lo, hi, start, end int, update U) {
+    if end <= lo || hi <= start {
+        return
+    }
+    if start <= lo && hi <= end {
+        st.applyNode(node, lo, hi, update)
+        return
+    }
+    st.push(node, lo, hi)
+    mid := (lo + hi) / 2
+    st.update(2*node, lo, mid, start, end, update)
+    st.update(2*node+1, mid, hi, start, end, update)
+    st.tree[node] = st.ops.Combine(st.tree[2*node], st.tree[2*node+1])
+}
+
+// Set replaces the element at index with value
+func (st *SegmentTree[T, U]) Set(index int, value T) error {
+    if index < 0 || index >= st.n {
+        return ErrIndexOutOfRange
+    }
+    st.set(1, 0, st.n, index, value)
+    return nil
+}
+
+func (st *SegmentTree[T, U]) set(node, lo, hi, index int, value T) {
+    if hi-lo == 1 {
+        st.tree[node] = value
+        return
+    }
+    st.push(node, lo, hi)
+    mid := (lo + hi) / 2
+    if index < mid {
+        st.set(2*node, lo, mid, index, value)
+    } else {
+        st.set(2*node+1, mid, hi, index, value)
+    }
+    st.tree[node] = st.ops.Combine(st.tree[2*node], st.tree[2*node+1])
+}
@@ -0,0 +1,146 @@
+package gohelpers
+
+import (
+    "errors"
+    "math"
+    "math/rand"
+    "testing"
+)
+
+func TestSparseTable(t *testing.T) {
+    r := rand.New(rand.NewSource(5))
+    for _, n := range []int{1, 2, 7, 64, 300} {
+        nums := make([]int, n)
+        for i := range nums {
+            nums[i] = r.Intn(1000) - 500
+        }
+        mins, maxs := NewMinSparseTable(nums), NewMaxSparseTable(nums)
+
+        for start := 0; start < n; start++ {
+            for end := start + 1; end <= n; end++ {
+                wantMin, _ := MinInSlice(nums[start:end])
+                wantMax, _ := MaxInSlice(nums[start:end])
+                gotMin, err1 := mins.Query(start, end)
+                gotMax, err2 := maxs.Query(start, end)
+                if err1 != nil || err2 != nil || gotMin != wantMin || gotMax != wantMax {
+                    t.Fatalf("n=%d Query(%d, %d) = %v, %v, want %v, %v", n, start, end, gotMin, gotMax, wantMin, wantMax)
+                }
+            }
+        }
+    }
+}
+
+func TestSparseTableOrderedTypes(t *testing.T) {
+    words := NewMinSparseTable([]string{"pear", "apple", "fig", "banana"})
+    if got, _ := words.Query(2, 4); got != "banana" {
+        t.Errorf("Query() = %v, want banana", got)
+    }
+
+    gcd := func(a, b int) int {
+        for b != 0 {
+            a, b = b, a%b
+        }
+        return a
+    }
+    table := NewSparseTable([]int{12, 18, 24, 9}, gcd)
+    if got, _ := table.Query(0, 3); got != 6 {
+        t.Errorf("gcd Query() = %v, want 6", got)
+    }
+}
+
+func TestSparseTableErrors(t *testing.T) {
+    table := NewMinSparseTable([]int{1, 2, 3})
+    if _, err := table.Query(1, 1); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Query() error = %v, want %v", err, ErrEmptySlice)
+    }
+    if _, err := table.Query(0, 4); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("Query() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    if _, err := NewMinSparseTable([]int{}).Query(0, 0); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Query() on empty table error = %v, want %v", err, ErrEmptySlice)
+    }
+}
+
+// minAddOps keeps range minimums under range additions
+var minAddOps = SegmentOps[int, int]{
+    Identity: math.MaxInt,
+    Combine:  func(a, b int) int { return Min(a, b) },
+    Apply:    func(value, add, _ int) int { return value + add },
+    Compose:  func(older, newer int) int { return older + newer },
+}
+
+// sumAssignOps keeps range sums under range assignments
+var sumAssignOps = SegmentOps[int, int]{
+    Identity: 0,
+    Combine:  func(a, b int) int { return a + b },
+    Apply:    func(_, value, length int) int { return value * length },
+    Compose:  func(_, newer int) int { return newer },
+}
+
+func TestSegmentTree(t *testing.T) {
+    r := rand.New(rand.NewSource(9))
+    nums := make([]int, 150)
+    for i := range nums {
+        nums[i] = r.Intn(1000)
+    }
+    mins := NewSegmentTree(nums, minAddOps)
+    sums := NewSegmentTree(nums, sumAssignOps)
+    minData := append([]int(nil), nums...)
+    sumData := append([]int(nil), nums...)
+
+    for step := 0; step < 2000; step++ {
+        start := r.Intn(len(nums))
+        end := start + 1 + r.Intn(len(nums)-start)
+        switch step % 3 {
+        case 0:
+            add := r.Intn(200) - 100
+            mins.Update(start, end, add)
+            for i := start; i < end; i++ {
+                minData[i] += add
+            }
+            value := r.Intn(50)
+            sums.Update(start, end, value)
+            for i := start; i < end; i++ {
+                sumData[i] = value
+            }
+        case 1:
+            value := r.Intn(1000)
+            mins.Set(start, value)
+            minData[start] = value
+            sums.Set(start, value)
+            sumData[start] = value
+        }
+
+        start = r.Intn(len(nums))
+        end = start + 1 + r.Intn(len(nums)-start)
+        wantMin, _ := MinInSlice(minData[start:end])
+        if got, err := mins.Query(start, end); err != nil || got != wantMin {
+            t.Fatalf("step %d: min Query(%d, %d) = %v, %v, want %v", step, start, end, got, err, wantMin)
+        }
+        if got, err := sums.Query(start, end); err != nil || got != Sum(sumData[start:end]) {
+            t.Fatalf("step %d: sum Query(%d, %d) = %v, %v, want %v", step, start, end, got, err, Sum(sumData[start:end]))
+        }
+    }
+}
+
+func TestSegmentTreeErrors(t *testing.T) {
+    tree := NewSegmentTree([]int{4, 5, 6}, sumAssignOps)
+    if tree.Len() != 3 {
+        t.Errorf("Len() = %v, want 3", tree.Len())
+    }
+    if got, err := tree.Query(2, 2); err != nil || got != 0 {
+        t.Errorf("Query() on empty range = %v, %v, want Identity", got, err)
+    }
+    if _, err := tree.Query(-1, 2); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("Query() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    if err := tree.Update(1, 4, 0); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("Update() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    if err := tree.Set(3, 0); !errors.Is(err, ErrIndexOutOfRange) {
+        t.Errorf("Set() error = %v, want %v", err, ErrIndexOutOfRange)
+    }
+    if got, _ := NewSegmentTree([]int{}, minAddOps).Query(0, 0); got != math.MaxInt {
+        t.Errorf("Query() on empty tree = %v, want Identity", got)
+    }
+}
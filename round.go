@@ -0,0 +1,152 @@
+package gohelpers
+
+import (
+    "math"
+    "math/big"
+    "strconv"
+    "strings"
+)
+
+// RoundingMode selects how a value is rounded to a given number of digits
+type RoundingMode int
+
+const (
+    // RoundHalfUp rounds to the nearest digit, with ties away from zero
+    RoundHalfUp RoundingMode = iota
+    // RoundHalfEven rounds to the nearest digit, with ties to the even digit (banker's rounding)
+    RoundHalfEven
+    // RoundHalfDown rounds to the nearest digit, with ties towards zero
+    RoundHalfDown
+    // RoundCeiling rounds towards positive infinity
+    RoundCeiling
+    // RoundFloor rounds towards negative infinity
+    RoundFloor
+    // RoundTruncate rounds towards zero
+    RoundTruncate
+)
+
+var bigTen = big.NewInt(10)
+
+// pow10Big returns 10^n as a *big.Int
+func pow10Big(n int) *big.Int {
+    return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// roundQuotient divides a non-negative mantissa by divisor and rounds the quotient according to mode
+func roundQuotient(mantissa, divisor *big.Int, negative bool, mode RoundingMode) *big.Int {
+    q, r := new(big.Int).QuoRem(mantissa, divisor, new(big.Int))
+    if r.Sign() == 0 {
+        return q
+    }
+    half := new(big.Int).Lsh(r, 1).Cmp(divisor)
+    increment := false
+    switch mode {
+    case RoundHalfEven:
+        increment = half > 0 || (half == 0 && q.Bit(0) == 1)
+    case RoundHalfDown:
+        increment = half > 0
+    case RoundCeiling:
+        increment = !negative
+    case RoundFloor:
+        increment = negative
+    case RoundTruncate:
+        increment = false
+    default:
+        increment = half >= 0
+    }
+    if increment {
+        q.Add(q, big.NewInt(1))
+    }
+    return q
+}
+
+// decimalParts splits a finite, non-zero float into the digits of its shortest decimal representation and the
+// power of ten of the leading digit, so that 1.005 becomes ("1005", 0) and 0.012 becomes ("12", -2)
+func decimalParts(x float64) (string, int) {
+    s := strconv.FormatFloat(math.Abs(x), 'e', -1, 64)
+    mantissa, exp, _ := strings.Cut(s, "e")
+    e, _ := strconv.Atoi(exp)
+    return strings.Replace(mantissa, ".", "", 1), e
+}
+
+// roundScaled rounds x to the given number of decimals and returns the result as an integer count of 10^-decimals
+func roundScaled(x float64, decimals int, mode RoundingMode) *big.Int {
+    if x == 0 {
+        return new(big.Int)
+    }
+    digits, e := decimalParts(x)
+    mantissa, _ := new(big.Int).SetString(digits, 10)
+    // x = mantissa * 10^shift / 10^decimals
+    shift := e - (len(digits) - 1) + decimals
+    if shift >= 0 {
+        return mantissa.Mul(mantissa, pow10Big(shift))
+    }
+    return roundQuotient(mantissa, pow10Big(-shift), x < 0, mode)
+}
+
+// formatScaled formats an unsigned scaled integer as a decimal string with the given number of decimals
+func formatScaled(scaled *big.Int, decimals int, negative bool) string {
+    s := scaled.String()
+    if decimals <= 0 {
+        if scaled.Sign() != 0 {
+            s += strings.Repeat("0", -decimals)
+        }
+    } else {
+        if len(s) <= decimals {
+            s = strings.Repeat("0", decimals-len(s)+1) + s
+        }
+        s = s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+    }
+    if negative && scaled.Sign() != 0 {
+        s = "-" + s
+    }
+    return s
+}
+
+// FormatDecimal rounds x to the given number of decimals using mode and returns the exact decimal string.
+// Rounding works on the shortest decimal representation of x, so 1.005 rounds to "1.01" with RoundHalfUp.
+// Negative decimals round to the left of the decimal point, so 1234 with -2 decimals becomes "1200".
+func FormatDecimal(x float64, decimals int, mode RoundingMode) string {
+    if math.IsNaN(x) || math.IsInf(x, 0) {
+        return strconv.FormatFloat(x, 'f', -1, 64)
+    }
+    return formatScaled(roundScaled(x, decimals, mode), decimals, x < 0)
+}
+
+// RoundWithMode rounds x to the given number of decimals using mode
+func RoundWithMode(x float64, decimals int, mode RoundingMode) float64 {
+    if math.IsNaN(x) || math.IsInf(x, 0) {
+        return x
+    }
+    result, _ := strconv.ParseFloat(FormatDecimal(x, decimals, mode), 64)
+    return result
+}
+
+// significantDecimals returns how many decimals keep the given number of significant figures of x
+func significantDecimals(x float64, figures int, mode RoundingMode) int {
+    _, e := decimalParts(x)
+    decimals := figures - 1 - e
+    // Rounding 9.99 up to two figures carries into a new leading digit, leaving one decimal too many
+    if len(roundScaled(x, decimals, mode).String()) > figures {
+        decimals--
+    }
+    return decimals
+}
+
+// FormatSignificant rounds x to the given number of significant figures (at least 1) using mode and
+// returns the exact decimal string
+func FormatSignificant(x float64, figures int, mode RoundingMode) string {
+    figures = Max(figures, 1)
+    if x == 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+        return FormatDecimal(x, figures-1, mode)
+    }
+    return FormatDecimal(x, significantDecimals(x, figures, mode), mode)
+}
+
+// RoundToSignificant rounds x to the given number of significant figures (at least 1) using mode
+func RoundToSignificant(x float64, figures int, mode RoundingMode) float64 {
+    if x == 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+        return x
+    }
+    return RoundWithMode(x, significantDecimals(x, Max(figures, 1), mode), mode)
+}
@@ -0,0 +1,113 @@
+package gohelpers
+
+import (
+    "math"
+    "testing"
+)
+
+func TestFormatDecimal(t *testing.T) {
+    tests := []struct {
+        name     string
+        x        float64
+        decimals int
+        mode     RoundingMode
+        expected string
+    }{
+        {"half up tie", 2.5, 0, RoundHalfUp, "3"},
+        {"half up negative tie", -2.5, 0, RoundHalfUp, "-3"},
+        {"half up binary error", 1.005, 2, RoundHalfUp, "1.01"},
+        {"half even tie down", 2.5, 0, RoundHalfEven, "2"},
+        {"half even tie up", 3.5, 0, RoundHalfEven, "4"},
+        {"half even cents", 1.125, 2, RoundHalfEven, "1.12"},
+        {"half even not a tie", 1.1251, 2, RoundHalfEven, "1.13"},
+        {"half down tie", 2.5, 0, RoundHalfDown, "2"},
+        {"half down negative tie", -2.5, 0, RoundHalfDown, "-2"},
+        {"half down above tie", 2.51, 0, RoundHalfDown, "3"},
+        {"ceiling positive", 1.001, 2, RoundCeiling, "1.01"},
+        {"ceiling negative", -1.009, 2, RoundCeiling, "-1.00"},
+        {"floor positive", 1.009, 2, RoundFloor, "1.00"},
+        {"floor negative", -1.001, 2, RoundFloor, "-1.01"},
+        {"truncate positive", 1.999, 2, RoundTruncate, "1.99"},
+        {"truncate negative", -1.999, 2, RoundTruncate, "-1.99"},
+        {"pads decimals", 1.5, 3, RoundHalfUp, "1.500"},
+        {"small value", 0.0049, 3, RoundHalfUp, "0.005"},
+        {"rounds to zero", -0.0001, 2, RoundHalfUp, "0.00"},
+        {"negative decimals", 1250, -2, RoundHalfEven, "1200"},
+        {"negative decimals to zero", 49, -2, RoundHalfUp, "0"},
+        {"large value", 1e20, 1, RoundHalfUp, "100000000000000000000.0"},
+        {"NaN", math.NaN(), 2, RoundHalfUp, "NaN"},
+        {"infinity", math.Inf(-1), 2, RoundHalfUp, "-Inf"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := FormatDecimal(tt.x, tt.decimals, tt.mode)
+            if got != tt.expected {
+                t.Errorf("FormatDecimal(%v, %d) = %q, want %q", tt.x, tt.decimals, got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestRoundWithMode(t *testing.T) {
+    tests := []struct {
+        name     string
+        x        float64
+        decimals int
+        mode     RoundingMode
+        expected float64
+    }{
+        {"half even", 0.125, 2, RoundHalfEven, 0.12},
+        {"half up", 0.125, 2, RoundHalfUp, 0.13},
+        {"ceiling", 0.121, 2, RoundCeiling, 0.13},
+        {"floor", -0.121, 2, RoundFloor, -0.13},
+        {"truncate", 9.99, 0, RoundTruncate, 9},
+        {"negative decimals", 15, -1, RoundHalfEven, 20},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := RoundWithMode(tt.x, tt.decimals, tt.mode)
+            if got != tt.expected {
+                t.Errorf("RoundWithMode() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    t.Run("NaN", func(t *testing.T) {
+        if got := RoundWithMode(math.NaN(), 2, RoundHalfUp); !math.IsNaN(got) {
+            t.Errorf("RoundWithMode() = %v, want NaN", got)
+        }
+    })
+}
+
+func TestSignificantFigures(t *testing.T) {
+    tests := []struct {
+        name        string
+        x           float64
+        figures     int
+        mode        RoundingMode
+        expected    float64
+        expectedStr string
+    }{
+        {"large number", 123456, 3, RoundHalfUp, 123000, "123000"},
+        {"small number", 0.00123456, 2, RoundHalfUp, 0.0012, "0.0012"},
+        {"keeps trailing zeros", 1.5, 3, RoundHalfUp, 1.5, "1.50"},
+        {"carry into new digit", 9.99, 2, RoundHalfUp, 10, "10"},
+        {"negative", -4.567, 2, RoundHalfEven, -4.6, "-4.6"},
+        {"floor", 4.567, 2, RoundFloor, 4.5, "4.5"},
+        {"zero", 0, 3, RoundHalfUp, 0, "0.00"},
+        {"figures clamped to one", 47, 0, RoundHalfUp, 50, "50"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := RoundToSignificant(tt.x, tt.figures, tt.mode); got != tt.expected {
+                t.Errorf("RoundToSignificant() = %v, want %v", got, tt.expected)
+            }
+            if got := FormatSignificant(tt.x, tt.figures, tt.mode); got != tt.expectedStr {
+                t.Errorf("FormatSignificant() = %q, want %q", got, tt.expectedStr)
+            }
+        })
+    }
+}
@@ -0,0 +1,247 @@
+package gohelpers
+
+import (
+    "errors"
+    "fmt"
+    "iter"
+    "math"
+    "math/rand/v2"
+)
+
+var (
+    // ErrInvalidSampleSize is returned when asking for more distinct elements than a slice holds, or a negative number
+    ErrInvalidSampleSize = errors.New("invalid sample size")
+    // ErrInvalidWeights is returned for weights that are mismatched in length, negative, not finite or all zero
+    ErrInvalidWeights = errors.New("invalid weights")
+)
+
+// randIntN returns a uniform int in [0, n) from r, or from the global math/rand/v2 source if r is nil
+func randIntN(r *rand.Rand, n int) int {
+    if r == nil {
+        return rand.IntN(n)
+    }
+    return r.IntN(n)
+}
+
+// randFloat64 returns a uniform float64 in [0, 1) from r, or from the global math/rand/v2 source if r is nil
+func randFloat64(r *rand.Rand) float64 {
+    if r == nil {
+        return rand.Float64()
+    }
+    return r.Float64()
+}
+
+// Sample returns k distinct elements of slice chosen uniformly at random, in random order. It uses
+// Floyd's algorithm, so it runs in O(k) time and space however long the slice is. A nil r uses the
+// global math/rand/v2 source.
+func Sample[T any](slice []T, k int, r *rand.Rand) ([]T, error) {
+    n := len(slice)
+    if k < 0 || k > n {
+        return nil, fmt.Errorf("%w: %d from %d elements", ErrInvalidSampleSize, k, n)
+    }
+    chosen := make(map[int]bool, k)
+    result := make([]T, 0, k)
+    for j := n - k; j < n; j++ {
+        t := randIntN(r, j+1)
+        if chosen[t] {
+            t = j
+        }
+        chosen[t] = true
+        result = append(result, slice[t])
+    }
+    // Floyd's algorithm picks a uniform subset but not a uniform order
+    ShuffleInPlaceWith(result, r)
+    return result, nil
+}
+
+// Choice returns one element of slice chosen uniformly at random. A nil r uses the global math/rand/v2 source.
+func Choice[T any](slice []T, r *rand.Rand) (T, error) {
+    if len(slice) == 0 {
+        var zero T
+        return zero, ErrEmptySlice
+    }
+    return slice[randIntN(r, len(slice))], nil
+}
+
+// checkWeights validates weights for n items and returns their total
+func checkWeights(n int, weights []float64) (float64, error) {
+    if n == 0 {
+        return 0, ErrEmptySlice
+    }
+    if len(weights) != n {
+        return 0, fmt.Errorf("%w: %d weights for %d elements", ErrInvalidWeights, len(weights), n)
+    }
+    total := 0.0
+    for i, w := range weights {
+        if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+            return 0, fmt.Errorf("%w: weight %v at index %d", ErrInvalidWeights, w, i)
+        }
+        total += w
+    }
+    if total == 0 || math.IsInf(total, 0) {
+        return 0, fmt.Errorf("%w: total weight %v", ErrInvalidWeights, total)
+    }
+    return total, nil
+}
+
+// ChoiceWeighted returns one element of slice chosen with probability proportional to its weight in O(n).
+// Use an AliasTable for repeated draws from the same weights. A nil r uses the global math/rand/v2 source.
+func ChoiceWeighted[T any](slice []T, weights []float64, r *rand.Rand) (T, error) {
+    total, err := checkWeights(len(slice), weights)
+    if err != nil {
+        var zero T
+        return zero, err
+    }
+    target := randFloat64(r) * total
+    last := 0
+    for i, w := range weights {
+        if w == 0 {
+            continue
+        }
+        if target < w {
+            return slice[i], nil
+        }
+        target -= w
+        last = i
+    }
+    // Rounding can leave a sliver of target after the last weight
+    return slice[last], nil
+}
+
+// AliasTable draws weighted random elements in O(1) each, after O(n) setup, using Walker's alias method
+// (in Vose's numerically stable form)
+type AliasTable[T any] struct {
+    items []T
+    prob  []float64
+    alias []int
+}
+
+// NewAliasTable builds an AliasTable over copies of items and weights
+func NewAliasTable[T any](items []T, weights []float64) (*AliasTable[T], error) {
+    total, err := checkWeights(len(items), weights)
+    if err != nil {
+        return nil, err
+    }
+    n := len(items)
+    t := &AliasTable[T]{
+        items: append([]T(nil), items...),
+        prob:  make([]float64, n),
+        alias: make([]int, n),
+    }
+
+    // Scale weights so the average is 1, then pair each under-full column with an over-full one
+    scaled := make([]float64, n)
+    var small, large []int
+    for i, w := range weights {
+        scaled[i] = w * float64(n) / total
+        if scaled[i] < 1 {
+            small = append(small, i)
+        } else {
+            large = append(large, i)
+        }
+    }
+    for len(small) > 0 && len(large) > 0 {
+        s, l := small[len(small)-1], large[len(large)-1]
+        small = small[:len(small)-1]
+        t.prob[s], t.alias[s] = scaled[s], l
+        scaled[l] -= 1 - scaled[s]
+        if scaled[l] < 1 {
+            large = large[:len(large)-1]
+            small = append(small, l)
+        }
+    }
+    // Whatever is left is full up to rounding error
+    for _, i := range append(small, large...) {
+        t.prob[i], t.alias[i] = 1, i
+    }
+    return t, nil
+}
+
+// Len returns the number of items in the table
+func (t *AliasTable[T]) Len() int {
+    return len(t.items)
+}
+
+// Draw returns one item chosen with probability proportional to its weight. A nil r uses the
+// global math/rand/v2 source.
+func (t *AliasTable[T]) Draw(r *rand.Rand) T {
+    i := randIntN(r, len(t.items))
+    if randFloat64(r) < t.prob[i] {
+        return t.items[i]
+    }
+    return t.items[t.alias[i]]
+}
+
+// Reservoir keeps a uniform random sample of at most k of the values added to it, without knowing in advance
+// how many values there will be (Algorithm R)
+type Reservoir[T any] struct {
+    k      int
+    seen   int
+    sample []T
+    r      *rand.Rand
+}
+
+// NewReservoir returns an empty reservoir holding up to k values (at least 1). A nil r uses the global
+// math/rand/v2 source.
+func NewReservoir[T any](k int, r *rand.Rand) *Reservoir[T] {
+    k = Max(k, 1)
+    return &Reservoir[T]{k: k, sample: make([]T, 0, k), r: r}
+}
+
+// Add offers a value to the reservoir
+func (res *Reservoir[T]) Add(x T) {
+    res.seen++
+    if len(res.sample) < res.k {
+        res.sample = append(res.sample, x)
+        return
+    }
+    if j := randIntN(res.r, res.seen); j < res.k {
+        res.sample[j] = x
+    }
+}
+
+// Count returns how many values have been added
+func (res *Reservoir[T]) Count() int {
+    return res.seen
+}
+
+// Sample returns a copy of the current sample, which holds min(k, Count()) values
+func (res *Reservoir[T]) Sample() []T {
+    return append([]T{}, res.sample...)
+}
+
+// ReservoirSample returns a uniform random sample of at most k values from seq in a single pass.
+// A nil r uses the global math/rand/v2 source.
+func ReservoirSample[T any](seq iter.Seq[T], k int, r *rand.Rand) []T {
+    if k <= 0 {
+        return []T{}
+    }
+    res := NewReservoir[T](k, r)
+    for v := range seq {
+        res.Add(v)
+    }
+    return res.Sample()
+}
+
+// StratifiedSample groups slice by keyFunc, like GroupBy, and samples up to k elements from each group
+// without replacement, so small groups are not crowded out by large ones. keyFunc is called once per
+// element. A nil r uses the global math/rand/v2 source.
+func StratifiedSample[T any, K comparable](slice []T, keyFunc func(T) K, k int, r *rand.Rand) map[K][]T {
+    groups := make(map[K][]T)
+    // Sample groups in order of first appearance rather than map order, so a seeded r is reproducible
+    var order []K
+    for _, item := range slice {
+        key := keyFunc(item)
+        if _, ok := groups[key]; !ok {
+            order = append(order, key)
+        }
+        groups[key] = append(groups[key], item)
+    }
+    result := make(map[K][]T, len(groups))
+    for _, key := range order {
+        group := groups[key]
+        // The sample size is clamped to the group, so Sample cannot fail
+        result[key], _ = Sample(group, Max(Min(k, len(group)), 0), r)
+    }
+    return result
+}
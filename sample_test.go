@@ -0,0 +1,217 @@
+package gohelpers
+
+import (
+    "errors"
+    "math"
+    "reflect"
+    "sort"
+    "testing"
+)
+
+func TestSample(t *testing.T) {
+    nums := Range(0, 100)
+
+    got, err := Sample(nums, 10, NewSeededRand(1))
+    if err != nil {
+        t.Fatalf("Sample() error = %v", err)
+    }
+    if len(got) != 10 || len(Unique(got)) != 10 {
+        t.Errorf("Sample() = %v, want 10 distinct elements", got)
+    }
+    for _, v := range got {
+        if v < 0 || v >= 100 {
+            t.Errorf("Sample() returned %d, not in the input", v)
+        }
+    }
+    if again, _ := Sample(nums, 10, NewSeededRand(1)); !reflect.DeepEqual(again, got) {
+        t.Errorf("Sample() with the same seed = %v, want %v", again, got)
+    }
+
+    all, _ := Sample(nums, 100, NewSeededRand(2))
+    sort.Ints(all)
+    if !reflect.DeepEqual(all, nums) {
+        t.Errorf("Sample() of every element did not return a permutation")
+    }
+    if none, err := Sample(nums, 0, nil); err != nil || len(none) != 0 {
+        t.Errorf("Sample(k=0) = %v, %v", none, err)
+    }
+
+    for _, k := range []int{-1, 101} {
+        if _, err := Sample(nums, k, nil); !errors.Is(err, ErrInvalidSampleSize) {
+            t.Errorf("Sample(k=%d) error = %v, want ErrInvalidSampleSize", k, err)
+        }
+    }
+}
+
+func TestSampleIsUniform(t *testing.T) {
+    // Every element of a 10-element slice should appear in a 3-element sample 30% of the time
+    r := NewSeededRand(3)
+    counts := make([]int, 10)
+    const trials = 20000
+    for i := 0; i < trials; i++ {
+        got, _ := Sample(Range(0, 10), 3, r)
+        for _, v := range got {
+            counts[v]++
+        }
+    }
+    for v, c := range counts {
+        if p := float64(c) / trials; math.Abs(p-0.3) > 0.02 {
+            t.Errorf("Sample() chose %d with frequency %.3f, want 0.3", v, p)
+        }
+    }
+}
+
+func TestChoice(t *testing.T) {
+    got, err := Choice([]string{"a", "b", "c"}, NewSeededRand(1))
+    if err != nil || !Contains([]string{"a", "b", "c"}, got) {
+        t.Errorf("Choice() = %q, %v", got, err)
+    }
+    if _, err := Choice([]string{}, nil); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Choice() error = %v, want ErrEmptySlice", err)
+    }
+}
+
+func TestChoiceWeighted(t *testing.T) {
+    items := []string{"never", "rare", "common"}
+    weights := []float64{0, 1, 3}
+
+    r := NewSeededRand(4)
+    counts := make(map[string]int)
+    const trials = 20000
+    for i := 0; i < trials; i++ {
+        got, err := ChoiceWeighted(items, weights, r)
+        if err != nil {
+            t.Fatalf("ChoiceWeighted() error = %v", err)
+        }
+        counts[got]++
+    }
+    if counts["never"] != 0 {
+        t.Errorf("ChoiceWeighted() chose a zero-weight item %d times", counts["never"])
+    }
+    if p := float64(counts["common"]) / trials; math.Abs(p-0.75) > 0.02 {
+        t.Errorf("ChoiceWeighted() chose common with frequency %.3f, want 0.75", p)
+    }
+
+    tests := []struct {
+        name    string
+        items   []string
+        weights []float64
+        wantErr error
+    }{
+        {"empty", []string{}, []float64{}, ErrEmptySlice},
+        {"length mismatch", items, []float64{1, 2}, ErrInvalidWeights},
+        {"negative", items, []float64{1, -1, 1}, ErrInvalidWeights},
+        {"NaN", items, []float64{1, math.NaN(), 1}, ErrInvalidWeights},
+        {"all zero", items, []float64{0, 0, 0}, ErrInvalidWeights},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if _, err := ChoiceWeighted(tt.items, tt.weights, nil); !errors.Is(err, tt.wantErr) {
+                t.Errorf("ChoiceWeighted() error = %v, want %v", err, tt.wantErr)
+            }
+            if _, err := NewAliasTable(tt.items, tt.weights); !errors.Is(err, tt.wantErr) {
+                t.Errorf("NewAliasTable() error = %v, want %v", err, tt.wantErr)
+            }
+        })
+    }
+}
+
+func TestAliasTable(t *testing.T) {
+    weights := []float64{1, 0, 2, 3, 4}
+    table, err := NewAliasTable(Range(0, 5), weights)
+    if err != nil {
+        t.Fatalf("NewAliasTable() error = %v", err)
+    }
+    if table.Len() != 5 {
+        t.Errorf("Len() = %d, want 5", table.Len())
+    }
+
+    r := NewSeededRand(5)
+    counts := make([]int, 5)
+    const trials = 50000
+    for i := 0; i < trials; i++ {
+        counts[table.Draw(r)]++
+    }
+    for i, w := range weights {
+        want := w / 10
+        if p := float64(counts[i]) / trials; math.Abs(p-want) > 0.01 {
+            t.Errorf("Draw() chose %d with frequency %.3f, want %.1f", i, p, want)
+        }
+    }
+}
+
+func TestReservoir(t *testing.T) {
+    res := NewReservoir[int](5, NewSeededRand(6))
+    for i := 0; i < 3; i++ {
+        res.Add(i)
+    }
+    if got := res.Sample(); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+        t.Errorf("Sample() before the reservoir fills = %v, want [0 1 2]", got)
+    }
+    for i := 3; i < 1000; i++ {
+        res.Add(i)
+    }
+    if res.Count() != 1000 {
+        t.Errorf("Count() = %d, want 1000", res.Count())
+    }
+    if got := res.Sample(); len(got) != 5 || len(Unique(got)) != 5 {
+        t.Errorf("Sample() = %v, want 5 distinct values", got)
+    }
+
+    // Every value of a stream should be kept with probability k/n
+    r := NewSeededRand(7)
+    counts := make([]int, 20)
+    const trials = 20000
+    for i := 0; i < trials; i++ {
+        for _, v := range ReservoirSample(RangeSeq(0, 20, 1), 4, r) {
+            counts[v]++
+        }
+    }
+    for v, c := range counts {
+        if p := float64(c) / trials; math.Abs(p-0.2) > 0.02 {
+            t.Errorf("ReservoirSample() kept %d with frequency %.3f, want 0.2", v, p)
+        }
+    }
+
+    if got := ReservoirSample(RangeSeq(0, 20, 1), 0, nil); len(got) != 0 {
+        t.Errorf("ReservoirSample(k=0) = %v, want []", got)
+    }
+}
+
+func TestStratifiedSample(t *testing.T) {
+    nums := Range(0, 100)
+    byTens := func(x int) int { return x / 10 }
+    small := append(Range(0, 2), 50)
+
+    got := StratifiedSample(append(nums, small...), byTens, 3, NewSeededRand(8))
+    if len(got) != 10 {
+        t.Fatalf("StratifiedSample() returned %d groups, want 10", len(got))
+    }
+    for key, group := range got {
+        if len(group) != 3 {
+            t.Errorf("StratifiedSample() group %d = %v, want 3 elements", key, group)
+        }
+        for _, v := range group {
+            if byTens(v) != key {
+                t.Errorf("StratifiedSample() put %d in group %d", v, key)
+            }
+        }
+    }
+
+    got = StratifiedSample(small, byTens, 3, nil)
+    sort.Ints(got[0])
+    if !reflect.DeepEqual(got[0], []int{0, 1}) || !reflect.DeepEqual(got[5], []int{50}) {
+        t.Errorf("StratifiedSample() of small groups = %v, want whole groups", got)
+    }
+
+    calls := 0
+    StratifiedSample(nums, func(x int) int { calls++; return byTens(x) }, 3, nil)
+    if calls != len(nums) {
+        t.Errorf("StratifiedSample() called keyFunc %d times, want %d", calls, len(nums))
+    }
+
+    again := StratifiedSample(nums, byTens, 3, NewSeededRand(9))
+    if want := StratifiedSample(nums, byTens, 3, NewSeededRand(9)); !reflect.DeepEqual(again, want) {
+        t.Errorf("StratifiedSample() with the same seed = %v, want %v", again, want)
+    }
+}
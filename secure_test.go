@@ -0,0 +1,144 @@
+package gohelpers
+
+import (
+    "errors"
+    "math"
+    "reflect"
+    "sort"
+    "strings"
+    "sync"
+    "testing"
+    "unicode/utf8"
+)
+
+func TestSecureShuffle(t *testing.T) {
+    original := Range(0, 50)
+    got := SecureShuffle(original)
+    if !reflect.DeepEqual(original, Range(0, 50)) {
+        t.Errorf("SecureShuffle() modified its input")
+    }
+    sort.Ints(got)
+    if !reflect.DeepEqual(got, original) {
+        t.Errorf("SecureShuffle() did not return a permutation")
+    }
+
+    slice := Range(0, 50)
+    SecureShuffleInPlace(slice)
+    sort.Ints(slice)
+    if !reflect.DeepEqual(slice, original) {
+        t.Errorf("SecureShuffleInPlace() did not produce a permutation")
+    }
+}
+
+func TestSecureSampleAndChoice(t *testing.T) {
+    got, err := SecureSample(Range(0, 100), 10)
+    if err != nil || len(Unique(got)) != 10 {
+        t.Errorf("SecureSample() = %v, %v, want 10 distinct elements", got, err)
+    }
+    if _, err := SecureSample(Range(0, 5), 6); !errors.Is(err, ErrInvalidSampleSize) {
+        t.Errorf("SecureSample() error = %v, want ErrInvalidSampleSize", err)
+    }
+
+    if v, err := SecureChoice([]int{7}); err != nil || v != 7 {
+        t.Errorf("SecureChoice() = %v, %v, want 7", v, err)
+    }
+    if _, err := SecureChoice([]int{}); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("SecureChoice() error = %v, want ErrEmptySlice", err)
+    }
+}
+
+func TestSecureIntRange(t *testing.T) {
+    // A range of 3 does not divide 2^64, so a modulo-biased draw would favour 0
+    counts := make(map[int]int)
+    const trials = 30000
+    for i := 0; i < trials; i++ {
+        v, err := SecureIntRange(-1, 2)
+        if err != nil {
+            t.Fatalf("SecureIntRange() error = %v", err)
+        }
+        counts[v]++
+    }
+    for v := -1; v < 2; v++ {
+        if p := float64(counts[v]) / trials; math.Abs(p-1.0/3) > 0.02 {
+            t.Errorf("SecureIntRange(-1, 2) returned %d with frequency %.3f, want 0.333", v, p)
+        }
+    }
+    if len(counts) != 3 {
+        t.Errorf("SecureIntRange(-1, 2) returned values %v outside the range", counts)
+    }
+
+    // Full-width ranges must not overflow
+    for i := 0; i < 100; i++ {
+        if v, err := SecureIntRange[int8](math.MinInt8, math.MaxInt8); err != nil || v == math.MaxInt8 {
+            t.Fatalf("SecureIntRange[int8]() = %d, %v", v, err)
+        }
+        if v, err := SecureIntRange[uint64](math.MaxUint64-1, math.MaxUint64); err != nil || v != math.MaxUint64-1 {
+            t.Fatalf("SecureIntRange[uint64]() = %d, %v", v, err)
+        }
+        if _, err := SecureIntRange[int64](math.MinInt64, math.MaxInt64); err != nil {
+            t.Fatalf("SecureIntRange[int64]() error = %v", err)
+        }
+    }
+
+    for _, r := range [][2]int{{0, 0}, {5, 1}} {
+        if _, err := SecureIntRange(r[0], r[1]); !errors.Is(err, ErrEmptyRange) {
+            t.Errorf("SecureIntRange(%d, %d) error = %v, want ErrEmptyRange", r[0], r[1], err)
+        }
+    }
+}
+
+func TestSecureString(t *testing.T) {
+    tests := []struct {
+        name     string
+        n        int
+        alphabet string
+        wantErr  error
+    }{
+        {"alphanumeric", 32, AlphabetAlphanumeric, nil},
+        {"hex", 16, AlphabetHex, nil},
+        {"multibyte", 10, "αβγδ", nil},
+        {"zero length", 0, AlphabetDigits, nil},
+        {"empty alphabet", 10, "", ErrInvalidAlphabet},
+        {"repeated character", 10, "aab", ErrInvalidAlphabet},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := SecureString(tt.n, tt.alphabet)
+            if !errors.Is(err, tt.wantErr) {
+                t.Fatalf("SecureString() error = %v, want %v", err, tt.wantErr)
+            }
+            if err != nil {
+                return
+            }
+            if utf8.RuneCountInString(got) != tt.n {
+                t.Errorf("SecureString() = %q, want %d characters", got, tt.n)
+            }
+            for _, c := range got {
+                if !strings.ContainsRune(tt.alphabet, c) {
+                    t.Errorf("SecureString() = %q, contains %q outside the alphabet", got, c)
+                }
+            }
+        })
+    }
+
+    a, _ := SecureString(32, AlphabetAlphanumeric)
+    b, _ := SecureString(32, AlphabetAlphanumeric)
+    if a == b {
+        t.Errorf("SecureString() returned %q twice", a)
+    }
+}
+
+func TestSecureRandConcurrent(t *testing.T) {
+    var wg sync.WaitGroup
+    for i := 0; i < 8; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for j := 0; j < 100; j++ {
+                SecureShuffle(Range(0, 10))
+                SecureRand().IntN(10)
+            }
+        }()
+    }
+    wg.Wait()
+}
@@ -0,0 +1,96 @@
+package gohelpers
+
+import (
+    "cmp"
+    "container/heap"
+    "sort"
+)
+
+// MinBy returns the smallest element according to less and its index; ties go to the first occurrence
+func MinBy[T any](slice []T, less func(a, b T) bool) (T, int, error) {
+    var zero T
+    if len(slice) == 0 {
+        return zero, -1, ErrEmptySlice
+    }
+    best := 0
+    for i := 1; i < len(slice); i++ {
+        if less(slice[i], slice[best]) {
+            best = i
+        }
+    }
+    return slice[best], best, nil
+}
+
+// MaxBy returns the largest element according to less and its index; ties go to the first occurrence
+func MaxBy[T any](slice []T, less func(a, b T) bool) (T, int, error) {
+    return MinBy(slice, func(a, b T) bool { return less(b, a) })
+}
+
+// MinByKey returns the element with the smallest key and its index; ties go to the first occurrence
+func MinByKey[T any, K cmp.Ordered](slice []T, key func(T) K) (T, int, error) {
+    return MinBy(slice, func(a, b T) bool { return key(a) < key(b) })
+}
+
+// MaxByKey returns the element with the largest key and its index; ties go to the first occurrence
+func MaxByKey[T any, K cmp.Ordered](slice []T, key func(T) K) (T, int, error) {
+    return MaxBy(slice, func(a, b T) bool { return key(a) < key(b) })
+}
+
+// rankHeap is a heap of slice indices whose root is the worst of the kept elements
+type rankHeap struct {
+    indices []int
+    better  func(i, j int) bool
+}
+
+func (h *rankHeap) Len() int           { return len(h.indices) }
+func (h *rankHeap) Less(i, j int) bool { return h.better(h.indices[j], h.indices[i]) }
+func (h *rankHeap) Swap(i, j int)      { h.indices[i], h.indices[j] = h.indices[j], h.indices[i] }
+func (h *rankHeap) Push(x any)         { h.indices = append(h.indices, x.(int)) }
+func (h *rankHeap) Pop() any {
+    last := h.indices[len(h.indices)-1]
+    h.indices = h.indices[:len(h.indices)-1]
+    return last
+}
+
+// selectK keeps the k best elements of slice in a bounded heap and returns them best first.
+// Equal elements rank by position, so earlier elements win ties and keep their original order.
+func selectK[T any](slice []T, k int, first func(a, b T) bool) []T {
+    if k <= 0 {
+        return []T{}
+    }
+    better := func(i, j int) bool {
+        if first(slice[i], slice[j]) {
+            return true
+        }
+        if first(slice[j], slice[i]) {
+            return false
+        }
+        return i < j
+    }
+
+    h := &rankHeap{indices: make([]int, 0, Min(k, len(slice))), better: better}
+    for i := range slice {
+        if h.Len() < k {
+            heap.Push(h, i)
+        } else if better(i, h.indices[0]) {
+            h.indices[0] = i
+            heap.Fix(h, 0)
+        }
+    }
+
+    sort.Slice(h.indices, func(a, b int) bool { return better(h.indices[a], h.indices[b]) })
+    return Map(h.indices, func(i int) T { return slice[i] })
+}
+
+// TopK returns the k largest elements according to less, largest first, in O(n log k) time.
+// Equal elements are kept in their original order, and earlier ones are chosen first when a tie
+// straddles the cut-off. Fewer than k elements are returned if the slice is shorter than k.
+func TopK[T any](slice []T, k int, less func(a, b T) bool) []T {
+    return selectK(slice, k, func(a, b T) bool { return less(b, a) })
+}
+
+// BottomK returns the k smallest elements according to less, smallest first, in O(n log k) time.
+// Ties are broken the same way as TopK.
+func BottomK[T any](slice []T, k int, less func(a, b T) bool) []T {
+    return selectK(slice, k, less)
+}
@@ -0,0 +1,111 @@
+package gohelpers
+
+import (
+    "errors"
+    "math/rand"
+    "reflect"
+    "sort"
+    "testing"
+)
+
+type player struct {
+    Name  string
+    Score int
+}
+
+var players = []player{
+    {"Alice", 30},
+    {"Bob", 50},
+    {"Charlie", 10},
+    {"David", 50},
+    {"Eve", 10},
+}
+
+func byScore(a, b player) bool { return a.Score < b.Score }
+
+func TestMinByMaxBy(t *testing.T) {
+    tests := []struct {
+        name          string
+        f             func([]player) (player, int, error)
+        expected      string
+        expectedIndex int
+    }{
+        {"MinBy first tie", func(s []player) (player, int, error) { return MinBy(s, byScore) }, "Charlie", 2},
+        {"MaxBy first tie", func(s []player) (player, int, error) { return MaxBy(s, byScore) }, "Bob", 1},
+        {"MinByKey", func(s []player) (player, int, error) {
+            return MinByKey(s, func(p player) string { return p.Name })
+        }, "Alice", 0},
+        {"MaxByKey", func(s []player) (player, int, error) {
+            return MaxByKey(s, func(p player) string { return p.Name })
+        }, "Eve", 4},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, index, err := tt.f(players)
+            if err != nil {
+                t.Fatalf("unexpected error: %v", err)
+            }
+            if got.Name != tt.expected || index != tt.expectedIndex {
+                t.Errorf("got %v at %d, want %v at %d", got.Name, index, tt.expected, tt.expectedIndex)
+            }
+        })
+    }
+
+    t.Run("empty slice", func(t *testing.T) {
+        _, index, err := MaxBy([]player{}, byScore)
+        if !errors.Is(err, ErrEmptySlice) || index != -1 {
+            t.Errorf("MaxBy() = %d, %v, want -1, %v", index, err, ErrEmptySlice)
+        }
+    })
+}
+
+func TestTopKBottomK(t *testing.T) {
+    names := func(ps []player) []string { return Map(ps, func(p player) string { return p.Name }) }
+
+    tests := []struct {
+        name     string
+        got      []player
+        expected []string
+    }{
+        {"top 2", TopK(players, 2, byScore), []string{"Bob", "David"}},
+        {"top 1 tie goes to earlier", TopK(players, 1, byScore), []string{"Bob"}},
+        {"top 3", TopK(players, 3, byScore), []string{"Bob", "David", "Alice"}},
+        {"bottom 1 tie goes to earlier", BottomK(players, 1, byScore), []string{"Charlie"}},
+        {"bottom 3", BottomK(players, 3, byScore), []string{"Charlie", "Eve", "Alice"}},
+        {"k larger than slice", BottomK(players, 10, byScore), []string{"Charlie", "Eve", "Alice", "Bob", "David"}},
+        {"k zero", TopK(players, 0, byScore), []string{}},
+        {"empty slice", TopK([]player{}, 3, byScore), []string{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := names(tt.got); !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("got %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestTopKMatchesSort(t *testing.T) {
+    r := rand.New(rand.NewSource(7))
+    nums := make([]int, 1000)
+    for i := range nums {
+        nums[i] = r.Intn(100)
+    }
+    sorted := append([]int(nil), nums...)
+    sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+    less := func(a, b int) bool { return a < b }
+    for _, k := range []int{1, 10, 100, 1000} {
+        if got := TopK(nums, k, less); !reflect.DeepEqual(got, sorted[:k]) {
+            t.Errorf("TopK(%d) does not match sorted prefix", k)
+        }
+        if got := BottomK(nums, k, less); !reflect.DeepEqual(got, Reverse(sorted)[:k]) {
+            t.Errorf("BottomK(%d) does not match sorted prefix", k)
+        }
+    }
+    if got, _ := MaxInSlice(nums); got != TopK(nums, 1, less)[0] {
+        t.Errorf("TopK(1) = %v, want MaxInSlice() = %v", TopK(nums, 1, less)[0], got)
+    }
+}
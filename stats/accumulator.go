@@ -0,0 +1,132 @@
+package stats
+
+import (
+    "math"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+// Accumulator computes running statistics over values added one at a time.
+// The zero value is an empty accumulator ready for use. An Accumulator is not
+// safe for concurrent use; give each goroutine its own and combine them with Merge.
+type Accumulator[T gohelpers.Number] struct {
+    count int
+    min   T
+    max   T
+    sum   T
+    mean  float64
+    m2    float64
+}
+
+// Add records a single value
+func (a *Accumulator[T]) Add(x T) {
+    if a.count == 0 {
+        a.min, a.max = x, x
+    } else {
+        a.min = gohelpers.Min(a.min, x)
+        a.max = gohelpers.Max(a.max, x)
+    }
+    a.count++
+    a.sum += x
+
+    // Welford's update keeps the mean and squared deviations numerically stable
+    delta := float64(x) - a.mean
+    a.mean += delta / float64(a.count)
+    a.m2 += delta * (float64(x) - a.mean)
+}
+
+// AddAll records every value in a slice
+func (a *Accumulator[T]) AddAll(nums []T) {
+    for _, num := range nums {
+        a.Add(num)
+    }
+}
+
+// Merge folds the values recorded by other into a, leaving other unchanged
+func (a *Accumulator[T]) Merge(other *Accumulator[T]) {
+    if other.count == 0 {
+        return
+    }
+    if a.count == 0 {
+        *a = *other
+        return
+    }
+    n := float64(a.count + other.count)
+    delta := other.mean - a.mean
+    a.mean += delta * float64(other.count) / n
+    a.m2 += other.m2 + delta*delta*float64(a.count)*float64(other.count)/n
+    a.count += other.count
+    a.sum += other.sum
+    a.min = gohelpers.Min(a.min, other.min)
+    a.max = gohelpers.Max(a.max, other.max)
+}
+
+// Reset discards every recorded value
+func (a *Accumulator[T]) Reset() {
+    *a = Accumulator[T]{}
+}
+
+// Count returns the number of recorded values
+func (a *Accumulator[T]) Count() int {
+    return a.count
+}
+
+// Sum returns the sum of the recorded values
+func (a *Accumulator[T]) Sum() T {
+    return a.sum
+}
+
+// Min returns the smallest recorded value
+func (a *Accumulator[T]) Min() (T, error) {
+    if a.count == 0 {
+        return 0, ErrEmptySlice
+    }
+    return a.min, nil
+}
+
+// Max returns the largest recorded value
+func (a *Accumulator[T]) Max() (T, error) {
+    if a.count == 0 {
+        return 0, ErrEmptySlice
+    }
+    return a.max, nil
+}
+
+// Mean returns the arithmetic mean of the recorded values
+func (a *Accumulator[T]) Mean() (float64, error) {
+    if a.count == 0 {
+        return 0, ErrEmptySlice
+    }
+    return a.mean, nil
+}
+
+// PopulationVariance returns the variance of the recorded values treated as a whole population
+func (a *Accumulator[T]) PopulationVariance() (float64, error) {
+    if a.count == 0 {
+        return 0, ErrEmptySlice
+    }
+    return a.m2 / float64(a.count), nil
+}
+
+// SampleVariance returns the unbiased variance of the recorded values treated as a sample
+func (a *Accumulator[T]) SampleVariance() (float64, error) {
+    if a.count == 0 {
+        return 0, ErrEmptySlice
+    }
+    if a.count < 2 {
+        return 0, ErrTooFewValues
+    }
+    return a.m2 / float64(a.count-1), nil
+}
+
+// PopulationStdDev returns the population standard deviation of the recorded values
+func (a *Accumulator[T]) PopulationStdDev() (float64, error) {
+    variance, err := a.PopulationVariance()
+    return math.Sqrt(variance), err
+}
+
+// SampleStdDev returns the sample standard deviation of the recorded values
+func (a *Accumulator[T]) SampleStdDev() (float64, error) {
+    variance, err := a.SampleVariance()
+    return math.Sqrt(variance), err
+}
@@ -0,0 +1,118 @@
+package stats
+
+import (
+    "errors"
+    "math"
+    "math/rand"
+    "sync"
+    "testing"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+func TestAccumulatorMatchesSliceHelpers(t *testing.T) {
+    tests := []struct {
+        name  string
+        slice []int
+    }{
+        {"single element", []int{5}},
+        {"positive numbers", []int{1, 2, 3, 4, 5}},
+        {"mixed numbers", []int{-7, 3, 0, 12, -1, 3}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            var acc Accumulator[int]
+            acc.AddAll(tt.slice)
+
+            wantMin, _ := gohelpers.MinInSlice(tt.slice)
+            wantMax, _ := gohelpers.MaxInSlice(tt.slice)
+            wantMean, _ := gohelpers.Average(tt.slice)
+            wantVar, _ := PopulationVariance(tt.slice)
+
+            if got, _ := acc.Min(); got != wantMin {
+                t.Errorf("Min() = %v, want %v", got, wantMin)
+            }
+            if got, _ := acc.Max(); got != wantMax {
+                t.Errorf("Max() = %v, want %v", got, wantMax)
+            }
+            if got := acc.Sum(); got != gohelpers.Sum(tt.slice) {
+                t.Errorf("Sum() = %v, want %v", got, gohelpers.Sum(tt.slice))
+            }
+            if got := acc.Count(); got != len(tt.slice) {
+                t.Errorf("Count() = %v, want %v", got, len(tt.slice))
+            }
+            if got, _ := acc.Mean(); math.Abs(got-wantMean) > 1e-10 {
+                t.Errorf("Mean() = %v, want %v", got, wantMean)
+            }
+            if got, _ := acc.PopulationVariance(); math.Abs(got-wantVar) > 1e-10 {
+                t.Errorf("PopulationVariance() = %v, want %v", got, wantVar)
+            }
+        })
+    }
+}
+
+func TestAccumulatorEmpty(t *testing.T) {
+    var acc Accumulator[float64]
+    if _, err := acc.Min(); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Min() error = %v, want %v", err, ErrEmptySlice)
+    }
+    if _, err := acc.Mean(); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Mean() error = %v, want %v", err, ErrEmptySlice)
+    }
+    acc.Add(1)
+    if _, err := acc.SampleVariance(); !errors.Is(err, ErrTooFewValues) {
+        t.Errorf("SampleVariance() error = %v, want %v", err, ErrTooFewValues)
+    }
+    acc.Reset()
+    if acc.Count() != 0 {
+        t.Errorf("Count() after Reset() = %v, want 0", acc.Count())
+    }
+}
+
+func TestAccumulatorMerge(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    data := make([]float64, 10000)
+    for i := range data {
+        data[i] = r.NormFloat64()*50 + 1000
+    }
+
+    parts := gohelpers.Chunk(data, 1500)
+    accs := make([]Accumulator[float64], len(parts))
+    var wg sync.WaitGroup
+    for i, part := range parts {
+        wg.Add(1)
+        go func(i int, part []float64) {
+            defer wg.Done()
+            accs[i].AddAll(part)
+        }(i, part)
+    }
+    wg.Wait()
+
+    var merged Accumulator[float64]
+    merged.Merge(&Accumulator[float64]{})
+    for i := range accs {
+        merged.Merge(&accs[i])
+    }
+
+    wantMean, _ := Mean(data)
+    wantVar, _ := SampleVariance(data)
+    wantMin, _ := gohelpers.MinInSlice(data)
+    wantMax, _ := gohelpers.MaxInSlice(data)
+
+    if merged.Count() != len(data) {
+        t.Errorf("Count() = %v, want %v", merged.Count(), len(data))
+    }
+    if got, _ := merged.Mean(); math.Abs(got-wantMean) > 1e-9 {
+        t.Errorf("Mean() = %v, want %v", got, wantMean)
+    }
+    if got, _ := merged.SampleVariance(); math.Abs(got-wantVar) > 1e-6 {
+        t.Errorf("SampleVariance() = %v, want %v", got, wantVar)
+    }
+    if got, _ := merged.Min(); got != wantMin {
+        t.Errorf("Min() = %v, want %v", got, wantMin)
+    }
+    if got, _ := merged.Max(); got != wantMax {
+        t.Errorf("Max() = %v, want %v", got, wantMax)
+    }
+}
@@ -0,0 +1,135 @@
+package stats
+
+import (
+    "errors"
+    "math"
+    "sort"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+// ErrLengthMismatch is returned when two paired series have different lengths
+var ErrLengthMismatch = errors.New("length mismatch")
+
+// checkPair validates two paired series
+func checkPair[T gohelpers.Number](x, y []T) error {
+    if len(x) != len(y) {
+        return ErrLengthMismatch
+    }
+    if len(x) == 0 {
+        return ErrEmptySlice
+    }
+    return nil
+}
+
+// coSumSquares returns the sum of products of deviations from the means of x and y
+func coSumSquares[T gohelpers.Number](x, y []T) float64 {
+    meanX, _ := Mean(x)
+    meanY, _ := Mean(y)
+    total := 0.0
+    for i := range x {
+        total += (float64(x[i]) - meanX) * (float64(y[i]) - meanY)
+    }
+    return total
+}
+
+// PopulationCovariance returns the covariance of two series treated as a whole population
+func PopulationCovariance[T gohelpers.Number](x, y []T) (float64, error) {
+    if err := checkPair(x, y); err != nil {
+        return 0, err
+    }
+    return coSumSquares(x, y) / float64(len(x)), nil
+}
+
+// SampleCovariance returns the unbiased covariance of two series treated as a sample (n-1 denominator)
+func SampleCovariance[T gohelpers.Number](x, y []T) (float64, error) {
+    if err := checkPair(x, y); err != nil {
+        return 0, err
+    }
+    if len(x) < 2 {
+        return 0, ErrTooFewValues
+    }
+    return coSumSquares(x, y) / float64(len(x)-1), nil
+}
+
+// Pearson returns the Pearson correlation coefficient of two series, or ErrZeroVariance if either is constant
+func Pearson[T gohelpers.Number](x, y []T) (float64, error) {
+    if err := checkPair(x, y); err != nil {
+        return 0, err
+    }
+    sxx, syy := sumSquares(x), sumSquares(y)
+    if sxx == 0 || syy == 0 {
+        return 0, ErrZeroVariance
+    }
+    return coSumSquares(x, y) / math.Sqrt(sxx*syy), nil
+}
+
+// Ranks returns the 1-based rank of each value, giving tied values the average of their ranks
+func Ranks[T gohelpers.Number](nums []T) []float64 {
+    order := gohelpers.Range(0, len(nums))
+    sort.SliceStable(order, func(i, j int) bool { return nums[order[i]] < nums[order[j]] })
+    ranks := make([]float64, len(nums))
+    for start := 0; start < len(order); {
+        end := start + 1
+        for end < len(order) && nums[order[end]] == nums[order[start]] {
+            end++
+        }
+        // Positions start..end-1 hold ranks start+1..end, whose average is (start+1+end)/2
+        rank := float64(start+1+end) / 2
+        for _, i := range order[start:end] {
+            ranks[i] = rank
+        }
+        start = end
+    }
+    return ranks
+}
+
+// Spearman returns the Spearman rank correlation coefficient of two series, or ErrZeroVariance if either is constant
+func Spearman[T gohelpers.Number](x, y []T) (float64, error) {
+    if err := checkPair(x, y); err != nil {
+        return 0, err
+    }
+    return Pearson(Ranks(x), Ranks(y))
+}
+
+// Regression is the result of an ordinary least-squares fit of y = Slope*x + Intercept
+type Regression struct {
+    Slope     float64
+    Intercept float64
+    RSquared  float64
+    Residuals []float64
+}
+
+// Predict returns the fitted value at x
+func (r Regression) Predict(x float64) float64 {
+    return r.Slope*x + r.Intercept
+}
+
+// LinearRegression fits a straight line to paired series by ordinary least squares.
+// It returns ErrTooFewValues for fewer than two points and ErrZeroVariance if either series is constant.
+func LinearRegression[T gohelpers.Number](x, y []T) (Regression, error) {
+    if err := checkPair(x, y); err != nil {
+        return Regression{}, err
+    }
+    if len(x) < 2 {
+        return Regression{}, ErrTooFewValues
+    }
+    sxx, syy := sumSquares(x), sumSquares(y)
+    if sxx == 0 || syy == 0 {
+        return Regression{}, ErrZeroVariance
+    }
+
+    meanX, _ := Mean(x)
+    meanY, _ := Mean(y)
+    slope := coSumSquares(x, y) / sxx
+    fit := Regression{Slope: slope, Intercept: meanY - slope*meanX}
+
+    residualSquares := 0.0
+    fit.Residuals = make([]float64, len(x))
+    for i := range x {
+        fit.Residuals[i] = float64(y[i]) - fit.Predict(float64(x[i]))
+        residualSquares += fit.Residuals[i] * fit.Residuals[i]
+    }
+    fit.RSquared = 1 - residualSquares/syy
+    return fit, nil
+}
@@ -0,0 +1,114 @@
+package stats
+
+import (
+    "errors"
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestCovariance(t *testing.T) {
+    x := []float64{2, 4, 6, 8}
+    y := []float64{1, 3, 2, 6}
+
+    if got, _ := PopulationCovariance(x, y); math.Abs(got-3.5) > 1e-10 {
+        t.Errorf("PopulationCovariance() = %v, want 3.5", got)
+    }
+    if got, _ := SampleCovariance(x, y); math.Abs(got-14.0/3) > 1e-10 {
+        t.Errorf("SampleCovariance() = %v, want %v", got, 14.0/3)
+    }
+    variance, _ := SampleVariance(x)
+    if got, _ := SampleCovariance(x, x); got != variance {
+        t.Errorf("SampleCovariance(x, x) = %v, want %v", got, variance)
+    }
+}
+
+func TestCorrelation(t *testing.T) {
+    tests := []struct {
+        name     string
+        x, y     []float64
+        pearson  float64
+        spearman float64
+    }{
+        {"perfect positive", []float64{1, 2, 3, 4}, []float64{2, 4, 6, 8}, 1, 1},
+        {"perfect negative", []float64{1, 2, 3, 4}, []float64{8, 6, 4, 2}, -1, -1},
+        {"monotonic but not linear", []float64{1, 2, 3, 4, 5}, []float64{1, 4, 9, 16, 100}, 0.7952, 1},
+        {"with ties", []float64{1, 2, 2, 3}, []float64{1, 3, 2, 4}, 0.9487, 0.9487},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got, err := Pearson(tt.x, tt.y); err != nil || math.Abs(got-tt.pearson) > 1e-4 {
+                t.Errorf("Pearson() = %v, %v, want %v", got, err, tt.pearson)
+            }
+            if got, err := Spearman(tt.x, tt.y); err != nil || math.Abs(got-tt.spearman) > 1e-4 {
+                t.Errorf("Spearman() = %v, %v, want %v", got, err, tt.spearman)
+            }
+        })
+    }
+}
+
+func TestRanks(t *testing.T) {
+    got := Ranks([]int{10, 30, 20, 30, 10, 10})
+    expected := []float64{2, 5.5, 4, 5.5, 2, 2}
+    if !reflect.DeepEqual(got, expected) {
+        t.Errorf("Ranks() = %v, want %v", got, expected)
+    }
+}
+
+func TestLinearRegression(t *testing.T) {
+    x := []float64{1, 2, 3, 4, 5}
+    y := []float64{2.2, 4.1, 6.2, 7.9, 10.1}
+
+    got, err := LinearRegression(x, y)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(got.Slope-1.96) > 1e-10 || math.Abs(got.Intercept-0.22) > 1e-10 {
+        t.Errorf("LinearRegression() = %v x + %v, want 1.96 x + 0.22", got.Slope, got.Intercept)
+    }
+    if math.Abs(got.RSquared-0.998856) > 1e-6 {
+        t.Errorf("LinearRegression() RSquared = %v, want 0.998856", got.RSquared)
+    }
+    expectedResiduals := []float64{0.02, -0.04, 0.1, -0.16, 0.08}
+    for i, r := range got.Residuals {
+        if math.Abs(r-expectedResiduals[i]) > 1e-10 {
+            t.Errorf("LinearRegression() Residuals = %v, want %v", got.Residuals, expectedResiduals)
+            break
+        }
+    }
+    if p := got.Predict(6); math.Abs(p-11.98) > 1e-10 {
+        t.Errorf("Predict(6) = %v, want 11.98", p)
+    }
+}
+
+func TestPairErrors(t *testing.T) {
+    constant := []float64{3, 3, 3}
+    varying := []float64{1, 2, 3}
+
+    tests := []struct {
+        name    string
+        f       func(x, y []float64) error
+        x, y    []float64
+        wantErr error
+    }{
+        {"covariance mismatch", func(x, y []float64) error { _, err := PopulationCovariance(x, y); return err }, varying, varying[:2], ErrLengthMismatch},
+        {"covariance empty", func(x, y []float64) error { _, err := SampleCovariance(x, y); return err }, nil, nil, ErrEmptySlice},
+        {"sample covariance one point", func(x, y []float64) error { _, err := SampleCovariance(x, y); return err }, varying[:1], varying[:1], ErrTooFewValues},
+        {"pearson mismatch", func(x, y []float64) error { _, err := Pearson(x, y); return err }, varying, constant[:1], ErrLengthMismatch},
+        {"pearson constant", func(x, y []float64) error { _, err := Pearson(x, y); return err }, varying, constant, ErrZeroVariance},
+        {"spearman constant", func(x, y []float64) error { _, err := Spearman(x, y); return err }, constant, varying, ErrZeroVariance},
+        {"regression mismatch", func(x, y []float64) error { _, err := LinearRegression(x, y); return err }, varying[:2], varying, ErrLengthMismatch},
+        {"regression one point", func(x, y []float64) error { _, err := LinearRegression(x, y); return err }, varying[:1], varying[:1], ErrTooFewValues},
+        {"regression constant x", func(x, y []float64) error { _, err := LinearRegression(x, y); return err }, constant, varying, ErrZeroVariance},
+        {"regression constant y", func(x, y []float64) error { _, err := LinearRegression(x, y); return err }, varying, constant, ErrZeroVariance},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if err := tt.f(tt.x, tt.y); !errors.Is(err, tt.wantErr) {
+                t.Errorf("error = %v, want %v", err, tt.wantErr)
+            }
+        })
+    }
+}
@@ -0,0 +1,167 @@
+package stats
+
+import (
+    "errors"
+    "fmt"
+    "math"
+    "sort"
+    "strings"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+// ErrInvalidBins is returned for a non-positive bin count or edges that are not strictly increasing
+var ErrInvalidBins = errors.New("invalid bins")
+
+// Bins holds the result of Histogram. Bin i covers [Edges[i], Edges[i+1]), except the last bin,
+// which also includes its right edge, so len(Edges) == len(Counts)+1.
+type Bins struct {
+    Edges  []float64
+    Counts []int
+}
+
+// Binning chooses the bin edges for a histogram from sorted data
+type Binning interface {
+    edges(sorted []float64) ([]float64, error)
+}
+
+type fixedBinning int
+
+type edgeBinning []float64
+
+type sturgesBinning struct{}
+
+type freedmanDiaconisBinning struct{}
+
+// FixedWidth splits the range of the data into n bins of equal width
+func FixedWidth(n int) Binning {
+    return fixedBinning(n)
+}
+
+// Edges uses explicit, strictly increasing bin edges; values outside them are not counted
+func Edges(edges ...float64) Binning {
+    return edgeBinning(edges)
+}
+
+// Sturges picks ceil(log2(n)) + 1 equal-width bins, which suits roughly normal data
+func Sturges() Binning {
+    return sturgesBinning{}
+}
+
+// FreedmanDiaconis picks a bin width of 2*IQR/cbrt(n), which follows the spread of the bulk of the data.
+// It falls back to Sturges when the IQR is zero, or when that width would need more bins than there are
+// values, as happens when a few extreme outliers stretch the range.
+func FreedmanDiaconis() Binning {
+    return freedmanDiaconisBinning{}
+}
+
+// equalWidthEdges returns n+1 evenly spaced edges covering the sorted data
+func equalWidthEdges(sorted []float64, n int) []float64 {
+    lo, hi := sorted[0], sorted[len(sorted)-1]
+    if lo == hi {
+        lo, hi = lo-0.5, hi+0.5
+    }
+    width := (hi - lo) / float64(n)
+    edges := make([]float64, n+1)
+    for i := range edges {
+        edges[i] = lo + float64(i)*width
+    }
+    edges[n] = hi
+    return edges
+}
+
+func (b fixedBinning) edges(sorted []float64) ([]float64, error) {
+    if b < 1 {
+        return nil, ErrInvalidBins
+    }
+    return equalWidthEdges(sorted, int(b)), nil
+}
+
+func (b edgeBinning) edges(sorted []float64) ([]float64, error) {
+    if len(b) < 2 {
+        return nil, ErrInvalidBins
+    }
+    for i := 1; i < len(b); i++ {
+        if !(b[i] > b[i-1]) {
+            return nil, ErrInvalidBins
+        }
+    }
+    return append([]float64(nil), b...), nil
+}
+
+func (sturgesBinning) edges(sorted []float64) ([]float64, error) {
+    n := int(math.Ceil(math.Log2(float64(len(sorted))))) + 1
+    return equalWidthEdges(sorted, n), nil
+}
+
+func (freedmanDiaconisBinning) edges(sorted []float64) ([]float64, error) {
+    iqr, _ := IQR(sorted)
+    width := 2 * iqr / math.Cbrt(float64(len(sorted)))
+    if width == 0 {
+        return sturgesBinning{}.edges(sorted)
+    }
+    // Compare as floats, since a huge bin count would not fit in an int
+    n := math.Ceil((sorted[len(sorted)-1] - sorted[0]) / width)
+    if n > float64(len(sorted)) {
+        return sturgesBinning{}.edges(sorted)
+    }
+    return equalWidthEdges(sorted, gohelpers.Max(int(n), 1)), nil
+}
+
+// Histogram counts how many values fall into each bin chosen by binning. NaN values are skipped;
+// infinite values return ErrNotFinite, since they have no place in equal-width bins.
+func Histogram[T gohelpers.Number](nums []T, binning Binning) (Bins, error) {
+    if len(nums) == 0 {
+        return Bins{}, ErrEmptySlice
+    }
+    sorted := gohelpers.Filter(toSortedFloats(nums), func(x float64) bool { return !math.IsNaN(x) })
+    if len(sorted) == 0 {
+        return Bins{}, ErrEmptySlice
+    }
+    if math.IsInf(sorted[0], 0) || math.IsInf(sorted[len(sorted)-1], 0) {
+        return Bins{}, ErrNotFinite
+    }
+    edges, err := binning.edges(sorted)
+    if err != nil {
+        return Bins{}, err
+    }
+
+    counts := make([]int, len(edges)-1)
+    last := edges[len(edges)-1]
+    for _, x := range sorted {
+        if x < edges[0] || x > last {
+            continue
+        }
+        i := sort.SearchFloat64s(edges, x)
+        // SearchFloat64s finds the first edge >= x; a value on a left edge belongs to that edge's bin
+        if i < len(edges) && edges[i] == x {
+            i++
+        }
+        counts[gohelpers.Min(i, len(counts))-1]++
+    }
+    return Bins{Edges: edges, Counts: counts}, nil
+}
+
+// Render draws the histogram as an ASCII bar chart, one line per bin, scaling the longest bar to width characters
+func (b Bins) Render(width int) string {
+    labels := make([]string, len(b.Counts))
+    for i := range b.Counts {
+        closing := ")"
+        if i == len(b.Counts)-1 {
+            closing = "]"
+        }
+        labels[i] = fmt.Sprintf("[%g, %g%s", b.Edges[i], b.Edges[i+1], closing)
+    }
+    labelWidth, _ := gohelpers.MaxInSlice(gohelpers.Map(labels, func(s string) int { return len(s) }))
+    maxCount, _ := gohelpers.MaxInSlice(b.Counts)
+
+    var sb strings.Builder
+    for i, count := range b.Counts {
+        bar := 0
+        if maxCount > 0 {
+            bar = int(math.Round(float64(count) / float64(maxCount) * float64(gohelpers.Max(width, 0))))
+        }
+        fmt.Fprintf(&sb, "%-*s |%s %d\n", labelWidth, labels[i], strings.Repeat("#", bar), count)
+    }
+    return sb.String()
+}
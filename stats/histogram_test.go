@@ -0,0 +1,147 @@
+package stats
+
+import (
+    "errors"
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestHistogram(t *testing.T) {
+    tests := []struct {
+        name          string
+        slice         []float64
+        binning       Binning
+        expectedEdges []float64
+        expected      []int
+    }{
+        {
+            "fixed width",
+            []float64{0, 1, 2, 3, 4, 5, 6, 7, 8},
+            FixedWidth(4),
+            []float64{0, 2, 4, 6, 8},
+            []int{2, 2, 2, 3},
+        },
+        {
+            "fixed width single value",
+            []float64{3, 3, 3},
+            FixedWidth(1),
+            []float64{2.5, 3.5},
+            []int{3},
+        },
+        {
+            "explicit edges drop outliers",
+            []float64{-5, 0, 0.5, 1, 9.99, 10, 11},
+            Edges(0, 1, 10),
+            []float64{0, 1, 10},
+            []int{2, 3},
+        },
+        {
+            "sturges",
+            []float64{1, 2, 3, 4, 5, 6, 7, 8},
+            Sturges(),
+            []float64{1, 2.75, 4.5, 6.25, 8},
+            []int{2, 2, 2, 2},
+        },
+        {
+            "freedman-diaconis falls back to sturges",
+            []float64{1, 1, 1, 1, 1, 1, 1, 9},
+            FreedmanDiaconis(),
+            []float64{1, 3, 5, 7, 9},
+            []int{7, 0, 0, 1},
+        },
+        {
+            "NaN values are skipped",
+            []float64{0, math.NaN(), 1},
+            FixedWidth(1),
+            []float64{0, 1},
+            []int{2},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := Histogram(tt.slice, tt.binning)
+            if err != nil {
+                t.Fatalf("unexpected error: %v", err)
+            }
+            if !reflect.DeepEqual(got.Edges, tt.expectedEdges) {
+                t.Errorf("Histogram() edges = %v, want %v", got.Edges, tt.expectedEdges)
+            }
+            if !reflect.DeepEqual(got.Counts, tt.expected) {
+                t.Errorf("Histogram() counts = %v, want %v", got.Counts, tt.expected)
+            }
+        })
+    }
+}
+
+func TestFreedmanDiaconis(t *testing.T) {
+    data := make([]int, 1000)
+    for i := range data {
+        data[i] = i
+    }
+    got, err := Histogram(data, FreedmanDiaconis())
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    // IQR is 499.5, so the width is 2*499.5/10 = 99.9 and 999/99.9 gives 10 bins
+    if len(got.Counts) != 10 {
+        t.Errorf("Histogram() returned %d bins, want 10", len(got.Counts))
+    }
+    total := 0
+    for _, c := range got.Counts {
+        total += c
+    }
+    if total != len(data) {
+        t.Errorf("Histogram() counted %d values, want %d", total, len(data))
+    }
+
+    // One extreme outlier would need about 10^10 bins of width 99.9, so Sturges is used instead
+    got, err = Histogram(append(data, 1e12), FreedmanDiaconis())
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(got.Counts) != 11 {
+        t.Errorf("Histogram() with an outlier returned %d bins, want 11", len(got.Counts))
+    }
+    if got.Counts[0] != 1000 || got.Counts[10] != 1 {
+        t.Errorf("Histogram() with an outlier counts = %v, want 1000 in the first bin and 1 in the last", got.Counts)
+    }
+}
+
+func TestHistogramErrors(t *testing.T) {
+    tests := []struct {
+        name    string
+        slice   []float64
+        binning Binning
+        wantErr error
+    }{
+        {"empty slice", []float64{}, FixedWidth(3), ErrEmptySlice},
+        {"only NaN", []float64{math.NaN()}, FixedWidth(3), ErrEmptySlice},
+        {"positive infinity", []float64{1, 2, math.Inf(1)}, FixedWidth(2), ErrNotFinite},
+        {"negative infinity", []float64{math.Inf(-1), 1}, FreedmanDiaconis(), ErrNotFinite},
+        {"infinity with explicit edges", []float64{math.Inf(1), 1}, Edges(0, 2), ErrNotFinite},
+        {"zero bins", []float64{1}, FixedWidth(0), ErrInvalidBins},
+        {"single edge", []float64{1}, Edges(1), ErrInvalidBins},
+        {"unsorted edges", []float64{1}, Edges(0, 2, 1), ErrInvalidBins},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if _, err := Histogram(tt.slice, tt.binning); !errors.Is(err, tt.wantErr) {
+                t.Errorf("Histogram() error = %v, want %v", err, tt.wantErr)
+            }
+        })
+    }
+}
+
+func TestRender(t *testing.T) {
+    bins := Bins{Edges: []float64{0, 5, 10, 15}, Counts: []int{2, 4, 0}}
+    expected := "" +
+        "[0, 5)   |##### 2\n" +
+        "[5, 10)  |########## 4\n" +
+        "[10, 15] | 0\n"
+    if got := bins.Render(10); got != expected {
+        t.Errorf("Render() =\n%s\nwant\n%s", got, expected)
+    }
+}
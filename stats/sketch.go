@@ -0,0 +1,256 @@
+package stats
+
+import (
+    "encoding/binary"
+    "errors"
+    "math"
+    "math/rand/v2"
+    "sort"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+// ErrInvalidSketch is returned when decoding a Sketch from malformed data
+var ErrInvalidSketch = errors.New("invalid sketch data")
+
+// DefaultSketchK is the accuracy parameter used by a zero-value Sketch
+const DefaultSketchK = 200
+
+// sketchVersion is written as the first byte of the binary encoding
+const sketchVersion = 1
+
+// Sketch is a mergeable KLL quantile sketch. It answers approximate quantile and CDF queries over
+// a stream using O(k) memory. With accuracy parameter k the rank error of a query is roughly 1.7/k
+// with high probability (about 1% for the default k of 200), independent of how many values are added.
+// The zero value is an empty sketch with k = DefaultSketchK. A Sketch is not safe for concurrent use.
+type Sketch struct {
+    k        int
+    n        uint64
+    min, max float64
+    // levels[h] holds items that each stand for 2^h of the original values
+    levels [][]float64
+}
+
+// NewSketch returns an empty sketch with accuracy parameter k (at least 8); larger k is more accurate
+func NewSketch(k int) *Sketch {
+    return &Sketch{k: gohelpers.Max(k, 8)}
+}
+
+// init fills in defaults for a zero-value sketch
+func (s *Sketch) init() {
+    if s.k == 0 {
+        s.k = DefaultSketchK
+    }
+    if len(s.levels) == 0 {
+        s.levels = [][]float64{nil}
+    }
+}
+
+// Count returns the number of values added to the sketch
+func (s *Sketch) Count() uint64 {
+    return s.n
+}
+
+// Add records a single value; NaN is ignored
+func (s *Sketch) Add(x float64) {
+    if math.IsNaN(x) {
+        return
+    }
+    s.init()
+    if s.n == 0 {
+        s.min, s.max = x, x
+    } else {
+        s.min, s.max = gohelpers.Min(s.min, x), gohelpers.Max(s.max, x)
+    }
+    s.n++
+    s.levels[0] = append(s.levels[0], x)
+    s.compact()
+}
+
+// Merge folds the values summarized by other into s, leaving other unchanged
+func (s *Sketch) Merge(other *Sketch) {
+    if other.n == 0 {
+        return
+    }
+    s.init()
+    if s.n == 0 {
+        s.min, s.max = other.min, other.max
+    } else {
+        s.min, s.max = gohelpers.Min(s.min, other.min), gohelpers.Max(s.max, other.max)
+    }
+    s.n += other.n
+    for h, level := range other.levels {
+        if h == len(s.levels) {
+            s.levels = append(s.levels, nil)
+        }
+        s.levels[h] = append(s.levels[h], level...)
+    }
+    s.compact()
+}
+
+// levelCapacity returns how many items level h may hold; lower levels shrink geometrically
+func (s *Sketch) levelCapacity(h int) int {
+    depth := len(s.levels) - 1 - h
+    return gohelpers.Max(2, int(math.Ceil(float64(s.k)*math.Pow(2.0/3.0, float64(depth)))))
+}
+
+// compact halves full levels until the sketch fits within its total capacity
+func (s *Sketch) compact() {
+    for {
+        size, capacity := 0, 0
+        for h, level := range s.levels {
+            size += len(level)
+            capacity += s.levelCapacity(h)
+        }
+        if size < capacity {
+            return
+        }
+        for h, level := range s.levels {
+            if len(level) < s.levelCapacity(h) {
+                continue
+            }
+            if h+1 == len(s.levels) {
+                s.levels = append(s.levels, nil)
+            }
+            // Keeping every other item of the sorted level, starting at a random offset, keeps ranks unbiased
+            sort.Float64s(level)
+            pairs := len(level) / 2 * 2
+            for i := rand.IntN(2); i < pairs; i += 2 {
+                s.levels[h+1] = append(s.levels[h+1], level[i])
+            }
+            s.levels[h] = append([]float64(nil), level[pairs:]...)
+            break
+        }
+    }
+}
+
+// weightedItem is a retained item and the number of original values it stands for
+type weightedItem struct {
+    value  float64
+    weight uint64
+}
+
+// sortedItems returns every retained item in ascending order with its weight
+func (s *Sketch) sortedItems() []weightedItem {
+    items := make([]weightedItem, 0)
+    for h, level := range s.levels {
+        for _, x := range level {
+            items = append(items, weightedItem{x, 1 << h})
+        }
+    }
+    sort.Slice(items, func(i, j int) bool { return items[i].value < items[j].value })
+    return items
+}
+
+// Quantile returns an estimate of the q-th quantile, where q is between 0 and 1
+func (s *Sketch) Quantile(q float64) (float64, error) {
+    if s.n == 0 {
+        return 0, ErrEmptySlice
+    }
+    if q < 0 || q > 1 || math.IsNaN(q) {
+        return 0, ErrOutOfRange
+    }
+    if q == 0 {
+        return s.min, nil
+    }
+    if q == 1 {
+        return s.max, nil
+    }
+    items := s.sortedItems()
+    // The retained weights can total slightly less than n after compaction, so rank against their sum
+    var total uint64
+    for _, item := range items {
+        total += item.weight
+    }
+    target := q * float64(total)
+    var cumulative uint64
+    for _, item := range items {
+        cumulative += item.weight
+        if float64(cumulative) >= target {
+            return item.value, nil
+        }
+    }
+    return s.max, nil
+}
+
+// CDF returns an estimate of the fraction of values less than or equal to x
+func (s *Sketch) CDF(x float64) (float64, error) {
+    if s.n == 0 {
+        return 0, ErrEmptySlice
+    }
+    var below, total uint64
+    for _, item := range s.sortedItems() {
+        total += item.weight
+        if item.value <= x {
+            below += item.weight
+        }
+    }
+    return float64(below) / float64(total), nil
+}
+
+// MarshalBinary encodes the sketch compactly so it can be stored and merged in another process
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+    s.init()
+    buf := []byte{sketchVersion}
+    buf = binary.AppendUvarint(buf, uint64(s.k))
+    buf = binary.AppendUvarint(buf, s.n)
+    buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(s.min))
+    buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(s.max))
+    buf = binary.AppendUvarint(buf, uint64(len(s.levels)))
+    for _, level := range s.levels {
+        buf = binary.AppendUvarint(buf, uint64(len(level)))
+        for _, x := range level {
+            buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(x))
+        }
+    }
+    return buf, nil
+}
+
+// UnmarshalBinary replaces the sketch with one decoded from MarshalBinary output
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+    if len(data) == 0 || data[0] != sketchVersion {
+        return ErrInvalidSketch
+    }
+    data = data[1:]
+    readUvarint := func() (uint64, bool) {
+        v, n := binary.Uvarint(data)
+        if n <= 0 {
+            return 0, false
+        }
+        data = data[n:]
+        return v, true
+    }
+    readFloat := func() (float64, bool) {
+        if len(data) < 8 {
+            return 0, false
+        }
+        v := math.Float64frombits(binary.LittleEndian.Uint64(data))
+        data = data[8:]
+        return v, true
+    }
+
+    k, ok1 := readUvarint()
+    n, ok2 := readUvarint()
+    lo, ok3 := readFloat()
+    hi, ok4 := readFloat()
+    numLevels, ok5 := readUvarint()
+    if !(ok1 && ok2 && ok3 && ok4 && ok5) || k < 8 || numLevels == 0 || numLevels > 64 {
+        return ErrInvalidSketch
+    }
+    levels := make([][]float64, numLevels)
+    for h := range levels {
+        size, ok := readUvarint()
+        if !ok || size > uint64(len(data)/8) {
+            return ErrInvalidSketch
+        }
+        levels[h] = make([]float64, size)
+        for i := range levels[h] {
+            levels[h][i], _ = readFloat()
+        }
+    }
+    if len(data) != 0 {
+        return ErrInvalidSketch
+    }
+    *s = Sketch{k: int(k), n: n, min: lo, max: hi, levels: levels}
+    return nil
+}
@@ -0,0 +1,159 @@
+package stats
+
+import (
+    "errors"
+    "math"
+    "math/rand"
+    "sort"
+    "testing"
+)
+
+// rankError returns how far estimate is from the q-th quantile of sorted, measured in rank
+func rankError(sorted []float64, estimate, q float64) float64 {
+    rank := float64(sort.SearchFloat64s(sorted, estimate)) / float64(len(sorted))
+    return math.Abs(rank - q)
+}
+
+func sketchTestData(n int) []float64 {
+    r := rand.New(rand.NewSource(42))
+    data := make([]float64, n)
+    for i := range data {
+        data[i] = math.Exp(r.NormFloat64()) * 100 // skewed, like request latencies
+    }
+    return data
+}
+
+var sketchQuantiles = []float64{0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99}
+
+func TestSketchErrorBounds(t *testing.T) {
+    data := sketchTestData(100000)
+    sketch := NewSketch(200)
+    for _, x := range data {
+        sketch.Add(x)
+    }
+    sorted := toSortedFloats(data)
+
+    for _, q := range sketchQuantiles {
+        got, err := sketch.Quantile(q)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        exact, _ := Quantile(data, q, Linear)
+        if e := rankError(sorted, got, q); e > 0.02 {
+            t.Errorf("Quantile(%v) = %v (exact %v), rank error %v exceeds 0.02", q, got, exact, e)
+        }
+    }
+
+    for _, q := range sketchQuantiles {
+        x := sorted[int(q*float64(len(sorted)))]
+        got, _ := sketch.CDF(x)
+        if math.Abs(got-q) > 0.02 {
+            t.Errorf("CDF(%v) = %v, want about %v", x, got, q)
+        }
+    }
+
+    if sketch.Count() != uint64(len(data)) {
+        t.Errorf("Count() = %v, want %v", sketch.Count(), len(data))
+    }
+    if got, _ := sketch.Quantile(0); got != sorted[0] {
+        t.Errorf("Quantile(0) = %v, want exact minimum %v", got, sorted[0])
+    }
+    if got, _ := sketch.Quantile(1); got != sorted[len(sorted)-1] {
+        t.Errorf("Quantile(1) = %v, want exact maximum %v", got, sorted[len(sorted)-1])
+    }
+}
+
+func TestSketchSmallInputIsExact(t *testing.T) {
+    var sketch Sketch
+    for _, x := range []float64{5, 1, 4, 2, 3} {
+        sketch.Add(x)
+    }
+    sketch.Add(math.NaN())
+    if got, _ := sketch.Quantile(0.5); got != 3 {
+        t.Errorf("Quantile(0.5) = %v, want 3", got)
+    }
+    if got, _ := sketch.CDF(2); got != 0.4 {
+        t.Errorf("CDF(2) = %v, want 0.4", got)
+    }
+    if sketch.Count() != 5 {
+        t.Errorf("Count() = %v, want 5", sketch.Count())
+    }
+}
+
+func TestSketchMerge(t *testing.T) {
+    data := sketchTestData(50000)
+    merged := NewSketch(200)
+    for i := 0; i < len(data); i += 5000 {
+        part := NewSketch(200)
+        for _, x := range data[i : i+5000] {
+            part.Add(x)
+        }
+        merged.Merge(part)
+    }
+    merged.Merge(NewSketch(200))
+    sorted := toSortedFloats(data)
+
+    if merged.Count() != uint64(len(data)) {
+        t.Errorf("Count() = %v, want %v", merged.Count(), len(data))
+    }
+    for _, q := range sketchQuantiles {
+        got, _ := merged.Quantile(q)
+        if e := rankError(sorted, got, q); e > 0.02 {
+            t.Errorf("merged Quantile(%v) = %v, rank error %v exceeds 0.02", q, got, e)
+        }
+    }
+}
+
+func TestSketchSerialization(t *testing.T) {
+    data := sketchTestData(20000)
+    sketch := NewSketch(100)
+    for _, x := range data {
+        sketch.Add(x)
+    }
+
+    encoded, err := sketch.MarshalBinary()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    // Each retained item takes 8 bytes, so the encoding stays far smaller than the raw data
+    if len(encoded) > len(data) {
+        t.Errorf("MarshalBinary() produced %d bytes for %d values", len(encoded), len(data))
+    }
+
+    var decoded Sketch
+    if err := decoded.UnmarshalBinary(encoded); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for _, q := range sketchQuantiles {
+        want, _ := sketch.Quantile(q)
+        got, _ := decoded.Quantile(q)
+        if got != want {
+            t.Errorf("decoded Quantile(%v) = %v, want %v", q, got, want)
+        }
+    }
+
+    decoded.Merge(sketch)
+    if decoded.Count() != 2*sketch.Count() {
+        t.Errorf("Count() after merge = %v, want %v", decoded.Count(), 2*sketch.Count())
+    }
+
+    for _, bad := range [][]byte{nil, {9}, encoded[:len(encoded)-3], append(encoded, 0)} {
+        if err := new(Sketch).UnmarshalBinary(bad); !errors.Is(err, ErrInvalidSketch) {
+            t.Errorf("UnmarshalBinary() error = %v, want %v", err, ErrInvalidSketch)
+        }
+    }
+}
+
+func TestSketchErrors(t *testing.T) {
+    var sketch Sketch
+    if _, err := sketch.Quantile(0.5); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Quantile() error = %v, want %v", err, ErrEmptySlice)
+    }
+    if _, err := sketch.CDF(1); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("CDF() error = %v, want %v", err, ErrEmptySlice)
+    }
+    sketch.Add(1)
+    if _, err := sketch.Quantile(1.5); !errors.Is(err, ErrOutOfRange) {
+        t.Errorf("Quantile() error = %v, want %v", err, ErrOutOfRange)
+    }
+}
@@ -0,0 +1,204 @@
+// Package stats provides descriptive statistics built on the gohelpers math helpers
+package stats
+
+import (
+    "errors"
+    "math"
+    "sort"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+var (
+    // ErrEmptySlice is returned when a statistic needs at least one value
+    ErrEmptySlice = gohelpers.ErrEmptySlice
+    // ErrNotFinite is returned when a statistic cannot handle NaN or infinite values
+    ErrNotFinite = gohelpers.ErrNotFinite
+    // ErrTooFewValues is returned when a sample statistic needs at least two values
+    ErrTooFewValues = errors.New("too few values")
+    // ErrOutOfRange is returned for a percentile or quantile outside its valid range
+    ErrOutOfRange = errors.New("value out of range")
+    // ErrUnknownMethod is returned for an unsupported interpolation method
+    ErrUnknownMethod = errors.New("unknown interpolation method")
+    // ErrZeroVariance is returned when a statistic divides by a zero standard deviation
+    ErrZeroVariance = errors.New("zero variance")
+)
+
+// Interpolation selects how a quantile falling between two data points is computed
+type Interpolation int
+
+const (
+    // Linear interpolates between the two nearest points (NumPy's default, R type 7)
+    Linear Interpolation = iota
+    // Lower takes the lower of the two nearest points
+    Lower
+    // Higher takes the higher of the two nearest points
+    Higher
+    // Nearest takes the nearest point, rounding half to even
+    Nearest
+    // Midpoint takes the mean of the two nearest points
+    Midpoint
+)
+
+// toFloats returns a float64 copy of nums
+func toFloats[T gohelpers.Number](nums []T) []float64 {
+    return gohelpers.Map(nums, func(x T) float64 { return float64(x) })
+}
+
+// toSortedFloats returns a sorted float64 copy of nums; any NaN values sort first
+func toSortedFloats[T gohelpers.Number](nums []T) []float64 {
+    sorted := toFloats(nums)
+    sort.Float64s(sorted)
+    return sorted
+}
+
+// Mean returns the arithmetic mean of a slice, summing in float64 so small integer kinds cannot overflow
+func Mean[T gohelpers.Number](nums []T) (float64, error) {
+    return gohelpers.Average(toFloats(nums))
+}
+
+// Median returns the middle value of a slice, averaging the two middle values for even lengths.
+// It is NaN if any value is NaN.
+func Median[T gohelpers.Number](nums []T) (float64, error) {
+    return Quantile(nums, 0.5, Midpoint)
+}
+
+// Mode returns the most frequent values in ascending order; every value is returned when all are equally frequent.
+// As with gohelpers.Min and Max, NaN propagates: if any value is NaN the result is a single NaN.
+func Mode[T gohelpers.Number](nums []T) ([]T, error) {
+    if len(nums) == 0 {
+        return nil, ErrEmptySlice
+    }
+    counts := make(map[T]int)
+    best := 0
+    for _, num := range nums {
+        // NaN != NaN, so each NaN would otherwise become its own map key
+        if num != num {
+            return []T{num}, nil
+        }
+        counts[num]++
+        best = gohelpers.Max(best, counts[num])
+    }
+    modes := make([]T, 0)
+    for num, count := range counts {
+        if count == best {
+            modes = append(modes, num)
+        }
+    }
+    sort.Slice(modes, func(i, j int) bool { return modes[i] < modes[j] })
+    return modes, nil
+}
+
+// sumSquares returns the sum of squared deviations from the mean
+func sumSquares[T gohelpers.Number](nums []T) float64 {
+    mean, _ := Mean(nums)
+    total := 0.0
+    for _, num := range nums {
+        d := float64(num) - mean
+        total += d * d
+    }
+    return total
+}
+
+// PopulationVariance returns the variance of a slice treated as a whole population
+func PopulationVariance[T gohelpers.Number](nums []T) (float64, error) {
+    if len(nums) == 0 {
+        return 0, ErrEmptySlice
+    }
+    return sumSquares(nums) / float64(len(nums)), nil
+}
+
+// SampleVariance returns the unbiased variance of a slice treated as a sample (n-1 denominator)
+func SampleVariance[T gohelpers.Number](nums []T) (float64, error) {
+    if len(nums) == 0 {
+        return 0, ErrEmptySlice
+    }
+    if len(nums) < 2 {
+        return 0, ErrTooFewValues
+    }
+    return sumSquares(nums) / float64(len(nums)-1), nil
+}
+
+// PopulationStdDev returns the population standard deviation of a slice
+func PopulationStdDev[T gohelpers.Number](nums []T) (float64, error) {
+    variance, err := PopulationVariance(nums)
+    return math.Sqrt(variance), err
+}
+
+// SampleStdDev returns the sample standard deviation of a slice
+func SampleStdDev[T gohelpers.Number](nums []T) (float64, error) {
+    variance, err := SampleVariance(nums)
+    return math.Sqrt(variance), err
+}
+
+// Quantile returns the q-th quantile of a slice, where q is between 0 and 1. As with gohelpers.Min and Max,
+// NaN propagates: the result is NaN if any value is NaN, rather than depending on where NaN sorts.
+func Quantile[T gohelpers.Number](nums []T, q float64, method Interpolation) (float64, error) {
+    if len(nums) == 0 {
+        return 0, ErrEmptySlice
+    }
+    if q < 0 || q > 1 || math.IsNaN(q) {
+        return 0, ErrOutOfRange
+    }
+    if method < Linear || method > Midpoint {
+        return 0, ErrUnknownMethod
+    }
+    sorted := toSortedFloats(nums)
+    if math.IsNaN(sorted[0]) {
+        return math.NaN(), nil
+    }
+    pos := q * float64(len(sorted)-1)
+    lo := int(math.Floor(pos))
+    hi := int(math.Ceil(pos))
+    switch method {
+    case Linear:
+        frac := pos - float64(lo)
+        return sorted[lo] + (sorted[hi]-sorted[lo])*frac, nil
+    case Lower:
+        return sorted[lo], nil
+    case Higher:
+        return sorted[hi], nil
+    case Nearest:
+        return sorted[int(math.RoundToEven(pos))], nil
+    case Midpoint:
+        return (sorted[lo] + sorted[hi]) / 2, nil
+    default:
+        return 0, ErrUnknownMethod
+    }
+}
+
+// Percentile returns the p-th percentile of a slice, where p is between 0 and 100
+func Percentile[T gohelpers.Number](nums []T, p float64, method Interpolation) (float64, error) {
+    if p < 0 || p > 100 || math.IsNaN(p) {
+        return 0, ErrOutOfRange
+    }
+    return Quantile(nums, p/100, method)
+}
+
+// IQR returns the interquartile range (Q3 - Q1) of a slice using linear interpolation
+func IQR[T gohelpers.Number](nums []T) (float64, error) {
+    q1, err := Quantile(nums, 0.25, Linear)
+    if err != nil {
+        return 0, err
+    }
+    q3, err := Quantile(nums, 0.75, Linear)
+    if err != nil {
+        return 0, err
+    }
+    return q3 - q1, nil
+}
+
+// ZScores returns how many population standard deviations each value lies from the mean
+func ZScores[T gohelpers.Number](nums []T) ([]float64, error) {
+    mean, err := Mean(nums)
+    if err != nil {
+        return nil, err
+    }
+    stddev, _ := PopulationStdDev(nums)
+    if stddev == 0 {
+        return nil, ErrZeroVariance
+    }
+    return gohelpers.Map(nums, func(x T) float64 {
+        return (float64(x) - mean) / stddev
+    }), nil
+}
@@ -0,0 +1,215 @@
+package stats
+
+import (
+    "errors"
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestMedian(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []float64
+        expected float64
+        wantErr  error
+    }{
+        {"odd length", []float64{5, 1, 3}, 3, nil},
+        {"even length", []float64{4, 1, 3, 2}, 2.5, nil},
+        {"single element", []float64{7}, 7, nil},
+        {"empty slice", []float64{}, 0, ErrEmptySlice},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := Median(tt.slice)
+            if !errors.Is(err, tt.wantErr) {
+                t.Errorf("Median() error = %v, want %v", err, tt.wantErr)
+                return
+            }
+            if got != tt.expected {
+                t.Errorf("Median() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestMode(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []int
+        expected []int
+        wantErr  error
+    }{
+        {"single mode", []int{1, 2, 2, 3}, []int{2}, nil},
+        {"multi-modal", []int{3, 1, 3, 1, 2}, []int{1, 3}, nil},
+        {"all unique", []int{3, 2, 1}, []int{1, 2, 3}, nil},
+        {"empty slice", []int{}, nil, ErrEmptySlice},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := Mode(tt.slice)
+            if !errors.Is(err, tt.wantErr) {
+                t.Errorf("Mode() error = %v, want %v", err, tt.wantErr)
+                return
+            }
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("Mode() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+    nums := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+    tests := []struct {
+        name     string
+        f        func([]int) (float64, error)
+        expected float64
+    }{
+        {"population variance", PopulationVariance[int], 4},
+        {"sample variance", SampleVariance[int], 32.0 / 7},
+        {"population stddev", PopulationStdDev[int], 2},
+        {"sample stddev", SampleStdDev[int], math.Sqrt(32.0 / 7)},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := tt.f(nums)
+            if err != nil {
+                t.Fatalf("unexpected error: %v", err)
+            }
+            if math.Abs(got-tt.expected) > 1e-10 {
+                t.Errorf("got %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    t.Run("small integer kinds do not overflow", func(t *testing.T) {
+        got, _ := PopulationVariance([]int8{100, 100, 100})
+        if got != 0 {
+            t.Errorf("PopulationVariance() = %v, want 0", got)
+        }
+    })
+
+    t.Run("errors", func(t *testing.T) {
+        if _, err := PopulationVariance([]int{}); !errors.Is(err, ErrEmptySlice) {
+            t.Errorf("PopulationVariance() error = %v, want %v", err, ErrEmptySlice)
+        }
+        if _, err := SampleVariance([]int{1}); !errors.Is(err, ErrTooFewValues) {
+            t.Errorf("SampleVariance() error = %v, want %v", err, ErrTooFewValues)
+        }
+        if _, err := SampleStdDev([]int{}); !errors.Is(err, ErrEmptySlice) {
+            t.Errorf("SampleStdDev() error = %v, want %v", err, ErrEmptySlice)
+        }
+    })
+}
+
+func TestQuantile(t *testing.T) {
+    nums := []int{1, 2, 3, 4}
+
+    tests := []struct {
+        name     string
+        q        float64
+        method   Interpolation
+        expected float64
+        wantErr  error
+    }{
+        {"linear", 0.4, Linear, 2.2, nil},
+        {"lower", 0.4, Lower, 2, nil},
+        {"higher", 0.4, Higher, 3, nil},
+        {"nearest", 0.4, Nearest, 2, nil},
+        {"nearest half to even", 0.5, Nearest, 3, nil},
+        {"midpoint", 0.4, Midpoint, 2.5, nil},
+        {"minimum", 0, Linear, 1, nil},
+        {"maximum", 1, Linear, 4, nil},
+        {"below range", -0.1, Linear, 0, ErrOutOfRange},
+        {"above range", 1.1, Linear, 0, ErrOutOfRange},
+        {"unknown method", 0.5, Interpolation(99), 0, ErrUnknownMethod},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := Quantile(nums, tt.q, tt.method)
+            if !errors.Is(err, tt.wantErr) {
+                t.Errorf("Quantile() error = %v, want %v", err, tt.wantErr)
+                return
+            }
+            if math.Abs(got-tt.expected) > 1e-10 {
+                t.Errorf("Quantile() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestPercentile(t *testing.T) {
+    got, err := Percentile([]float64{15, 20, 35, 40, 50}, 40, Linear)
+    if err != nil || math.Abs(got-29) > 1e-10 {
+        t.Errorf("Percentile() = %v, %v, want 29, nil", got, err)
+    }
+    if _, err := Percentile([]float64{1}, 101, Linear); !errors.Is(err, ErrOutOfRange) {
+        t.Errorf("Percentile() error = %v, want %v", err, ErrOutOfRange)
+    }
+    if _, err := Percentile([]float64{}, 50, Linear); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Percentile() error = %v, want %v", err, ErrEmptySlice)
+    }
+}
+
+func TestIQR(t *testing.T) {
+    got, err := IQR([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+    if err != nil || got != 4 {
+        t.Errorf("IQR() = %v, %v, want 4, nil", got, err)
+    }
+    if _, err := IQR([]int{}); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("IQR() error = %v, want %v", err, ErrEmptySlice)
+    }
+}
+
+func TestZScores(t *testing.T) {
+    got, err := ZScores([]int{2, 4, 4, 4, 5, 5, 7, 9})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}
+    if !reflect.DeepEqual(got, expected) {
+        t.Errorf("ZScores() = %v, want %v", got, expected)
+    }
+    if _, err := ZScores([]int{3, 3}); !errors.Is(err, ErrZeroVariance) {
+        t.Errorf("ZScores() error = %v, want %v", err, ErrZeroVariance)
+    }
+    if _, err := ZScores([]int{}); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("ZScores() error = %v, want %v", err, ErrEmptySlice)
+    }
+}
+
+func TestNaN(t *testing.T) {
+    nan := math.NaN()
+    withNaN := [][]float64{{nan, 1, 2}, {1, nan, 2}, {1, 2, nan}}
+
+    for _, nums := range withNaN {
+        if got, err := Median(nums); err != nil || !math.IsNaN(got) {
+            t.Errorf("Median(%v) = %v, %v, want NaN", nums, got, err)
+        }
+        for _, method := range []Interpolation{Linear, Lower, Higher, Nearest, Midpoint} {
+            if got, err := Quantile(nums, 0.9, method); err != nil || !math.IsNaN(got) {
+                t.Errorf("Quantile(%v, %v) = %v, %v, want NaN", nums, method, got, err)
+            }
+        }
+        if got, err := IQR(nums); err != nil || !math.IsNaN(got) {
+            t.Errorf("IQR(%v) = %v, %v, want NaN", nums, got, err)
+        }
+        if got, err := Mean(nums); err != nil || !math.IsNaN(got) {
+            t.Errorf("Mean(%v) = %v, %v, want NaN", nums, got, err)
+        }
+    }
+
+    got, err := Mode([]float64{nan, nan, 1, 1, 1})
+    if err != nil || len(got) != 1 || !math.IsNaN(got[0]) {
+        t.Errorf("Mode() = %v, %v, want [NaN]", got, err)
+    }
+    if _, err := Quantile([]float64{nan}, 0.5, Interpolation(99)); !errors.Is(err, ErrUnknownMethod) {
+        t.Errorf("Quantile() error = %v, want %v", err, ErrUnknownMethod)
+    }
+}
@@ -0,0 +1,139 @@
+// Package stream provides a chainable Stream type on top of the lazy iter helpers, so pipelines read
+// left to right instead of inside out. Steps are fused: values flow through the whole chain one at
+// a time and no intermediate slices are built, except by Sort and Reverse, which must see every value.
+package stream
+
+import (
+    "iter"
+    "sort"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+    hiter "github.com/johnwroge/go_helpers/iter"
+)
+
+// Stream is a lazy, chainable sequence of values. Nothing runs until a terminal method such as
+// Collect, ForEach or Count is called. Type-changing steps such as Map and Chunk, and steps that need
+// comparable values such as Unique, are top-level functions because Go methods cannot take type
+// parameters. Create streams with Of or From.
+type Stream[T any] struct {
+    seq iter.Seq[T]
+}
+
+// Of returns a stream over the elements of a slice
+func Of[T any](slice []T) Stream[T] {
+    return Stream[T]{seq: hiter.FromSlice(slice)}
+}
+
+// From returns a stream over an iter.Seq
+func From[T any](seq iter.Seq[T]) Stream[T] {
+    return Stream[T]{seq: seq}
+}
+
+// Seq returns the stream as an iter.Seq, so it can be used with range
+func (s Stream[T]) Seq() iter.Seq[T] {
+    return s.seq
+}
+
+// Filter keeps the values that pass the test
+func (s Stream[T]) Filter(f func(T) bool) Stream[T] {
+    return Stream[T]{seq: hiter.Filter(s.seq, f)}
+}
+
+// Take keeps at most the first n values
+func (s Stream[T]) Take(n int) Stream[T] {
+    return Stream[T]{seq: hiter.Take(s.seq, n)}
+}
+
+// Skip drops the first n values
+func (s Stream[T]) Skip(n int) Stream[T] {
+    return Stream[T]{seq: hiter.Skip(s.seq, n)}
+}
+
+// TakeWhile keeps values until the first one that fails the test
+func (s Stream[T]) TakeWhile(f func(T) bool) Stream[T] {
+    return Stream[T]{seq: hiter.TakeWhile(s.seq, f)}
+}
+
+// Reverse yields the values in reverse order; it buffers the whole stream when iteration starts
+func (s Stream[T]) Reverse() Stream[T] {
+    seq := s.seq
+    return Stream[T]{seq: func(yield func(T) bool) {
+        buffered := hiter.ToSlice(seq)
+        for i := len(buffered) - 1; i >= 0; i-- {
+            if !yield(buffered[i]) {
+                return
+            }
+        }
+    }}
+}
+
+// Sort yields the values stably sorted by less; it buffers the whole stream when iteration starts
+func (s Stream[T]) Sort(less func(a, b T) bool) Stream[T] {
+    seq := s.seq
+    return Stream[T]{seq: func(yield func(T) bool) {
+        buffered := hiter.ToSlice(seq)
+        sort.SliceStable(buffered, func(i, j int) bool { return less(buffered[i], buffered[j]) })
+        for _, v := range buffered {
+            if !yield(v) {
+                return
+            }
+        }
+    }}
+}
+
+// Collect runs the stream and returns its values as a slice
+func (s Stream[T]) Collect() []T {
+    return hiter.ToSlice(s.seq)
+}
+
+// ForEach runs the stream and calls f for every value
+func (s Stream[T]) ForEach(f func(T)) {
+    for v := range s.seq {
+        f(v)
+    }
+}
+
+// Count runs the stream and returns how many values it yielded
+func (s Stream[T]) Count() int {
+    return hiter.Reduce(s.seq, 0, func(n int, _ T) int { return n + 1 })
+}
+
+// First runs the stream until its first value and returns it, or ErrEmptySlice if there is none
+func (s Stream[T]) First() (T, error) {
+    for v := range s.seq {
+        return v, nil
+    }
+    var zero T
+    return zero, gohelpers.ErrEmptySlice
+}
+
+// Map applies a function to each value of a stream
+func Map[T, U any](s Stream[T], f func(T) U) Stream[U] {
+    return Stream[U]{seq: hiter.Map(s.seq, f)}
+}
+
+// Unique keeps the first occurrence of each distinct value. It is a function rather than a method
+// because it needs comparable values, which a method on Stream[T any] cannot require.
+func Unique[T comparable](s Stream[T]) Stream[T] {
+    return Stream[T]{seq: hiter.Unique(s.seq)}
+}
+
+// Chunk groups the values of a stream into slices of the given size; the last chunk may be shorter
+func Chunk[T any](s Stream[T], size int) Stream[[]T] {
+    return Stream[[]T]{seq: hiter.Chunk(s.seq, size)}
+}
+
+// Reduce runs a stream and reduces it to a single value using a function
+func Reduce[T, U any](s Stream[T], initial U, f func(U, T) U) U {
+    return hiter.Reduce(s.seq, initial, f)
+}
+
+// GroupBy runs a stream and groups its values by a key function
+func GroupBy[T any, K comparable](s Stream[T], keyFunc func(T) K) map[K][]T {
+    result := make(map[K][]T)
+    for v := range s.seq {
+        key := keyFunc(v)
+        result[key] = append(result[key], v)
+    }
+    return result
+}
@@ -0,0 +1,126 @@
+package stream
+
+import (
+    "errors"
+    "iter"
+    "reflect"
+    "strconv"
+    "testing"
+
+    gohelpers "github.com/johnwroge/go_helpers"
+)
+
+func naturals() iter.Seq[int] {
+    return func(yield func(int) bool) {
+        for i := 0; ; i++ {
+            if !yield(i) {
+                return
+            }
+        }
+    }
+}
+
+func TestStreamMethods(t *testing.T) {
+    nums := []int{5, 3, 5, 8, 1, 3, 9, 2}
+    isOdd := func(x int) bool { return x%2 == 1 }
+    less := func(a, b int) bool { return a < b }
+
+    tests := []struct {
+        name     string
+        got      []int
+        expected []int
+    }{
+        {"filter", Of(nums).Filter(isOdd).Collect(), gohelpers.Filter(nums, isOdd)},
+        {"unique", Unique(Of(nums)).Collect(), gohelpers.Unique(nums)},
+        {"reverse", Of(nums).Reverse().Collect(), gohelpers.Reverse(nums)},
+        {"sort", Of(nums).Sort(less).Collect(), []int{1, 2, 3, 3, 5, 5, 8, 9}},
+        {"take", Of(nums).Take(3).Collect(), nums[:3]},
+        {"skip", Of(nums).Skip(6).Collect(), nums[6:]},
+        {"take while", Of(nums).TakeWhile(func(x int) bool { return x < 8 }).Collect(), nums[:3]},
+        {"chained", Unique(Of(nums)).Filter(isOdd).Sort(less).Reverse().Take(2).Collect(), []int{9, 5}},
+        {"empty", Of([]int{}).Filter(isOdd).Collect(), []int{}},
+        {"infinite source", From(naturals()).Filter(isOdd).Take(3).Collect(), []int{1, 3, 5}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if !reflect.DeepEqual(tt.got, tt.expected) {
+                t.Errorf("got %v, want %v", tt.got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestTypeChangingSteps(t *testing.T) {
+    s := Map(Of([]int{1, 2, 3, 4, 5}).Filter(func(x int) bool { return x != 3 }), strconv.Itoa)
+    if got := s.Collect(); !reflect.DeepEqual(got, []string{"1", "2", "4", "5"}) {
+        t.Errorf("Map() = %v", got)
+    }
+    if got := Chunk(Of([]int{1, 2, 3, 4, 5}), 2).Collect(); !reflect.DeepEqual(got, gohelpers.Chunk([]int{1, 2, 3, 4, 5}, 2)) {
+        t.Errorf("Chunk() = %v", got)
+    }
+    if got := Reduce(Of([]int{1, 2, 3}), 10, func(acc, x int) int { return acc + x }); got != 16 {
+        t.Errorf("Reduce() = %v, want 16", got)
+    }
+    groups := GroupBy(Of([]string{"go", "rust", "c", "java"}), func(s string) int { return len(s) })
+    if !reflect.DeepEqual(groups, map[int][]string{2: {"go"}, 4: {"rust", "java"}, 1: {"c"}}) {
+        t.Errorf("GroupBy() = %v", groups)
+    }
+}
+
+func TestTerminalMethods(t *testing.T) {
+    s := Of([]int{4, 5, 6})
+    if got := s.Count(); got != 3 {
+        t.Errorf("Count() = %v, want 3", got)
+    }
+    if got, err := s.Skip(1).First(); err != nil || got != 5 {
+        t.Errorf("First() = %v, %v, want 5, nil", got, err)
+    }
+    if _, err := s.Skip(3).First(); !errors.Is(err, gohelpers.ErrEmptySlice) {
+        t.Errorf("First() error = %v, want %v", err, gohelpers.ErrEmptySlice)
+    }
+    sum := 0
+    s.ForEach(func(x int) { sum += x })
+    if sum != 15 {
+        t.Errorf("ForEach() sum = %v, want 15", sum)
+    }
+    var ranged []int
+    for v := range s.Seq() {
+        ranged = append(ranged, v)
+    }
+    if !reflect.DeepEqual(ranged, []int{4, 5, 6}) {
+        t.Errorf("Seq() = %v", ranged)
+    }
+}
+
+func TestStreamIsLazyAndFused(t *testing.T) {
+    calls := 0
+    s := Map(From(naturals()), func(x int) int {
+        calls++
+        return x * 10
+    }).Filter(func(x int) bool { return x%20 == 0 })
+
+    if calls != 0 {
+        t.Fatalf("building the stream ran %d steps, want 0", calls)
+    }
+    got := s.Take(3).Collect()
+    if !reflect.DeepEqual(got, []int{0, 20, 40}) {
+        t.Errorf("Collect() = %v", got)
+    }
+    if calls != 5 {
+        t.Errorf("stream pulled %d values, want 5", calls)
+    }
+}
+
+func TestStreamAllocations(t *testing.T) {
+    nums := gohelpers.Range(0, 1000)
+    s := Map(Of(nums).Filter(func(x int) bool { return x%3 == 0 }), func(x int) int { return x * 2 })
+    allocs := testing.AllocsPerRun(10, func() {
+        total := 0
+        s.ForEach(func(x int) { total += x })
+    })
+    // The closures are set up once per run; no per-element or intermediate slice allocations happen
+    if allocs > 10 {
+        t.Errorf("ForEach() allocated %v times for %d elements", allocs, len(nums))
+    }
+}
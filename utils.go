@@ -1,73 +1,268 @@
 package gohelpers
 
 import (
+    "errors"
     "fmt"
     "math"
-    "math/rand"
+    "math/big"
+    "math/rand/v2"
     "strings"
-    "time"
 )
 
-// Min returns the smallest of two integers
-func Min(a, b int) int {
-    if a < b {
+var (
+    // ErrEmptySlice is returned by helpers that need at least one element
+    ErrEmptySlice = errors.New("empty slice")
+    // ErrOverflow is returned when an integer result does not fit in its type
+    ErrOverflow = errors.New("integer overflow")
+    // ErrNotFinite is returned when a float input is NaN or infinite
+    ErrNotFinite = errors.New("value is not finite")
+)
+
+// Integer is a constraint that permits any integer type, including named types such as time.Duration
+type Integer interface {
+    ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+        ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type
+type Float interface {
+    ~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type
+type Number interface {
+    Integer | Float
+}
+
+// isNaN reports whether x is a floating-point NaN; it is always false for integers
+func isNaN[T Number](x T) bool {
+    return x != x
+}
+
+// isFinite reports whether x is neither NaN nor infinite; it is always true for integers
+func isFinite[T Number](x T) bool {
+    return x-x == 0
+}
+
+// Min returns the smallest of two numbers (NaN if either is NaN)
+func Min[T Number](a, b T) T {
+    if isNaN(a) || a < b {
         return a
     }
     return b
 }
 
-// Max returns the largest of two integers
-func Max(a, b int) int {
-    if a > b {
+// Max returns the largest of two numbers (NaN if either is NaN)
+func Max[T Number](a, b T) T {
+    if isNaN(a) || a > b {
         return a
     }
     return b
 }
 
-// MinInSlice returns the smallest number in a slice
-func MinInSlice(nums []int) (int, error) {
+// MinInSlice returns the smallest number in a slice (NaN if the slice contains NaN)
+func MinInSlice[T Number](nums []T) (T, error) {
     if len(nums) == 0 {
-        return 0, fmt.Errorf("empty slice")
+        return 0, ErrEmptySlice
     }
     min := nums[0]
     for _, num := range nums[1:] {
-        if num < min {
-            min = num
-        }
+        min = Min(min, num)
     }
     return min, nil
 }
 
-// MaxInSlice returns the largest number in a slice
-func MaxInSlice(nums []int) (int, error) {
+// MaxInSlice returns the largest number in a slice (NaN if the slice contains NaN)
+func MaxInSlice[T Number](nums []T) (T, error) {
     if len(nums) == 0 {
-        return 0, fmt.Errorf("empty slice")
+        return 0, ErrEmptySlice
     }
     max := nums[0]
     for _, num := range nums[1:] {
-        if num > max {
-            max = num
-        }
+        max = Max(max, num)
     }
     return max, nil
 }
 
-// Sum returns the sum of a slice of integers
-func Sum(nums []int) int {
-    sum := 0
+// Sum returns the sum of a slice of numbers
+func Sum[T Number](nums []T) T {
+    var sum T
     for _, num := range nums {
         sum += num
     }
     return sum
 }
 
-// Average returns the average of a slice of integers
-func Average(nums []int) (float64, error) {
+// Average returns the average of a slice of numbers, summing in float64 so small integer kinds cannot overflow
+func Average[T Number](nums []T) (float64, error) {
     if len(nums) == 0 {
-        return 0, fmt.Errorf("empty slice")
+        return 0, ErrEmptySlice
     }
-    sum := Sum(nums)
-    return float64(sum) / float64(len(nums)), nil
+    sum := 0.0
+    for _, num := range nums {
+        sum += float64(num)
+    }
+    return sum / float64(len(nums)), nil
+}
+
+// SumChecked returns the sum of a slice of integers, or ErrOverflow if the sum wraps around
+func SumChecked[T Integer](nums []T) (T, error) {
+    var sum T
+    for i, num := range nums {
+        next := sum + num
+        if (num > 0 && next < sum) || (num < 0 && next > sum) {
+            return 0, fmt.Errorf("%w at index %d", ErrOverflow, i)
+        }
+        sum = next
+    }
+    return sum, nil
+}
+
+// SumBig returns the exact sum of a slice of integers as a *big.Int
+func SumBig[T Integer](nums []T) *big.Int {
+    sum := new(big.Int)
+    n := new(big.Int)
+    for _, num := range nums {
+        if num < 0 {
+            n.SetInt64(int64(num))
+        } else {
+            n.SetUint64(uint64(num))
+        }
+        sum.Add(sum, n)
+    }
+    return sum
+}
+
+// bigFloatPrec is wide enough to hold the exact sum of any float64 values
+const bigFloatPrec = 4096
+
+// SumBigFloat returns the exact sum of a slice of floats as a *big.Float, or ErrNotFinite for NaN or infinite values
+func SumBigFloat[T Float](nums []T) (*big.Float, error) {
+    sum := new(big.Float).SetPrec(bigFloatPrec)
+    n := new(big.Float)
+    for i, num := range nums {
+        f := float64(num)
+        if math.IsNaN(f) || math.IsInf(f, 0) {
+            return nil, fmt.Errorf("%w at index %d", ErrNotFinite, i)
+        }
+        sum.Add(sum, n.SetFloat64(f))
+    }
+    return sum, nil
+}
+
+// AverageBig returns the average of a slice of integers without overflowing the intermediate sum
+func AverageBig[T Integer](nums []T) (float64, error) {
+    if len(nums) == 0 {
+        return 0, ErrEmptySlice
+    }
+    sum := new(big.Float).SetPrec(bigFloatPrec).SetInt(SumBig(nums))
+    avg, _ := sum.Quo(sum, big.NewFloat(float64(len(nums)))).Float64()
+    return avg, nil
+}
+
+// SumMethod selects the algorithm used by SumFloat
+type SumMethod int
+
+const (
+    // SumNeumaier uses Neumaier's improved Kahan summation, which also handles terms larger than the running sum
+    SumNeumaier SumMethod = iota
+    // SumKahan uses classic Kahan compensated summation
+    SumKahan
+    // SumPairwise recursively sums halves, keeping the error growth logarithmic in the length
+    SumPairwise
+    // SumNaive adds values left to right, like Sum
+    SumNaive
+)
+
+// pairwiseBlock is the length below which pairwise summation falls back to a plain loop
+const pairwiseBlock = 8
+
+// SumFloat returns the sum of a slice of floats in float64 using the given method (SumNeumaier for unknown methods)
+func SumFloat[T Float](nums []T, method SumMethod) float64 {
+    switch method {
+    case SumKahan:
+        return sumKahan(nums)
+    case SumPairwise:
+        return sumPairwise(nums)
+    case SumNaive:
+        sum := 0.0
+        for _, num := range nums {
+            sum += float64(num)
+        }
+        return sum
+    default:
+        return sumNeumaier(nums)
+    }
+}
+
+// sumKahan carries the low-order bits lost by each addition into the next one. Once the sum is no
+// longer finite the correction is dropped, so infinities give the plain sum rather than NaN.
+func sumKahan[T Float](nums []T) float64 {
+    sum, c := 0.0, 0.0
+    for _, num := range nums {
+        y := float64(num) - c
+        t := sum + y
+        if isFinite(t) {
+            c = (t - sum) - y
+        } else {
+            c = 0
+        }
+        sum = t
+    }
+    return sum
+}
+
+// sumNeumaier compensates whichever of the sum and the term lost bits
+func sumNeumaier[T Float](nums []T) float64 {
+    sum, c := 0.0, 0.0
+    for _, num := range nums {
+        sum, c = neumaierAdd(sum, c, float64(num))
+    }
+    return sum + c
+}
+
+// neumaierAdd adds x to a running sum, carrying the bits lost to rounding into the compensation c.
+// The true total is sum + c. For integers c always stays zero, since wrapping arithmetic is exact.
+// Once the sum is no longer finite c is left alone, so infinities give the plain sum rather than NaN,
+// as with Python's math.fsum and NumPy.
+func neumaierAdd[T Number](sum, c, x T) (T, T) {
+    abs := func(v T) T {
+        if v < 0 {
+            return -v
+        }
+        return v
+    }
+    t := sum + x
+    if !isFinite(t) {
+        return t, c
+    }
+    if abs(sum) >= abs(x) {
+        c += (sum - t) + x
+    } else {
+        c += (x - t) + sum
+    }
+    return t, c
+}
+
+// sumPairwise splits the slice in half until it is small enough to add directly
+func sumPairwise[T Float](nums []T) float64 {
+    if len(nums) <= pairwiseBlock {
+        sum := 0.0
+        for _, num := range nums {
+            sum += float64(num)
+        }
+        return sum
+    }
+    mid := len(nums) / 2
+    return sumPairwise(nums[:mid]) + sumPairwise(nums[mid:])
+}
+
+// AverageFloat returns the average of a slice of floats using SumFloat with the given method
+func AverageFloat[T Float](nums []T, method SumMethod) (float64, error) {
+    if len(nums) == 0 {
+        return 0, ErrEmptySlice
+    }
+    return SumFloat(nums, method) / float64(len(nums)), nil
 }
 
 // Contains checks if an element exists in a slice
@@ -105,15 +300,43 @@ func Reverse[T any](slice []T) []T {
 
 // Shuffle randomly reorders elements in a slice
 func Shuffle[T any](slice []T) []T {
+    return ShuffleWith(slice, nil)
+}
+
+// ShuffleWith returns a copy of slice randomly reordered using r. A nil r uses the
+// automatically seeded global source from math/rand/v2
+func ShuffleWith[T any](slice []T, r *rand.Rand) []T {
     result := make([]T, len(slice))
     copy(result, slice)
-    rand.Seed(time.Now().UnixNano())
-    rand.Shuffle(len(result), func(i, j int) {
-        result[i], result[j] = result[j], result[i]
-    })
+    ShuffleInPlaceWith(result, r)
     return result
 }
 
+// ShuffleInPlace randomly reorders the elements of slice without allocating
+func ShuffleInPlace[T any](slice []T) {
+    ShuffleInPlaceWith(slice, nil)
+}
+
+// ShuffleInPlaceWith randomly reorders the elements of slice using r. A nil r uses the
+// automatically seeded global source from math/rand/v2
+func ShuffleInPlaceWith[T any](slice []T, r *rand.Rand) {
+    swap := func(i, j int) {
+        slice[i], slice[j] = slice[j], slice[i]
+    }
+    if r == nil {
+        rand.Shuffle(len(slice), swap)
+        return
+    }
+    r.Shuffle(len(slice), swap)
+}
+
+// NewSeededRand returns a deterministic random source for the given seed. The same seed always
+// produces the same sequence, so it gives reproducible shuffles in tests. The returned *rand.Rand
+// is not safe for concurrent use
+func NewSeededRand(seed uint64) *rand.Rand {
+    return rand.New(rand.NewPCG(seed, seed))
+}
+
 // Chunk splits a slice into chunks of specified size
 func Chunk[T any](slice []T, size int) [][]T {
     if size <= 0 || len(slice) == 0 {
@@ -174,6 +397,102 @@ func Reduce[T, U any](slice []T, initial U, f func(U, T) U) U {
     return result
 }
 
+// MapErr applies a fallible function to each element in a slice. With StopOnError it returns nil and the
+// first error wrapped in an *IndexError; with CollectErrors failed elements hold the zero value and
+// every error is returned joined.
+func MapErr[T, U any](slice []T, mode ErrorMode, f func(T) (U, error)) ([]U, error) {
+    result := make([]U, len(slice))
+    var errs []error
+    for i, v := range slice {
+        u, err := f(v)
+        if err != nil {
+            if mode == StopOnError {
+                return nil, &IndexError{Index: i, Err: err}
+            }
+            errs = append(errs, &IndexError{Index: i, Err: err})
+            continue
+        }
+        result[i] = u
+    }
+    return result, errors.Join(errs...)
+}
+
+// FilterErr returns a new slice with elements that pass a fallible test. Errors are handled as in
+// MapErr; with CollectErrors failed elements are dropped.
+func FilterErr[T any](slice []T, mode ErrorMode, f func(T) (bool, error)) ([]T, error) {
+    result := make([]T, 0)
+    var errs []error
+    for i, v := range slice {
+        ok, err := f(v)
+        if err != nil {
+            if mode == StopOnError {
+                return nil, &IndexError{Index: i, Err: err}
+            }
+            errs = append(errs, &IndexError{Index: i, Err: err})
+            continue
+        }
+        if ok {
+            result = append(result, v)
+        }
+    }
+    return result, errors.Join(errs...)
+}
+
+// ReduceErr reduces a slice to a single value using a fallible function. With StopOnError it returns the
+// zero value and the first error; with CollectErrors failed elements are skipped and the accumulator
+// carries on from the last successful step.
+func ReduceErr[T, U any](slice []T, initial U, mode ErrorMode, f func(U, T) (U, error)) (U, error) {
+    result := initial
+    var errs []error
+    for i, v := range slice {
+        next, err := f(result, v)
+        if err != nil {
+            if mode == StopOnError {
+                var zero U
+                return zero, &IndexError{Index: i, Err: err}
+            }
+            errs = append(errs, &IndexError{Index: i, Err: err})
+            continue
+        }
+        result = next
+    }
+    return result, errors.Join(errs...)
+}
+
+// GroupByErr groups slice elements by a fallible key function. Errors are handled as in MapErr;
+// with CollectErrors failed elements are left out of every group.
+func GroupByErr[T any, K comparable](slice []T, mode ErrorMode, keyFunc func(T) (K, error)) (map[K][]T, error) {
+    result := make(map[K][]T)
+    var errs []error
+    for i, item := range slice {
+        key, err := keyFunc(item)
+        if err != nil {
+            if mode == StopOnError {
+                return nil, &IndexError{Index: i, Err: err}
+            }
+            errs = append(errs, &IndexError{Index: i, Err: err})
+            continue
+        }
+        result[key] = append(result[key], item)
+    }
+    return result, errors.Join(errs...)
+}
+
+// ForEachErr calls a fallible function for each element in a slice. With StopOnError it stops at
+// the first error; with CollectErrors it visits every element and returns all errors joined.
+func ForEachErr[T any](slice []T, mode ErrorMode, f func(T) error) error {
+    var errs []error
+    for i, v := range slice {
+        if err := f(v); err != nil {
+            if mode == StopOnError {
+                return &IndexError{Index: i, Err: err}
+            }
+            errs = append(errs, &IndexError{Index: i, Err: err})
+        }
+    }
+    return errors.Join(errs...)
+}
+
 // Join concatenates strings with a separator (like Python's join)
 func Join(elements []string, separator string) string {
     return strings.Join(elements, separator)
@@ -199,10 +518,9 @@ func IsNumeric(s string) bool {
     return true
 }
 
-// RoundToDecimals rounds a float64 to n decimal places
+// RoundToDecimals rounds a float64 to n decimal places, with ties away from zero (see RoundWithMode)
 func RoundToDecimals(x float64, decimals int) float64 {
-    pow := math.Pow10(decimals)
-    return math.Round(x*pow) / pow
+    return RoundWithMode(x, decimals, RoundHalfUp)
 }
 
 // Keys returns all keys from a map
@@ -2,10 +2,14 @@
 package gohelpers
 
 import (
+    "errors"
     "testing"
     "reflect"
     "math"
+    "math/big"
 	"sort"
+    "strconv"
+    "time"
 )
 
 func TestMin(t *testing.T) {
@@ -203,6 +207,10 @@ func TestRoundToDecimals(t *testing.T) {
         {"round to 1 decimal", 3.14159, 1, 3.1},
         {"round up", 3.16, 1, 3.2},
         {"zero decimals", 3.14159, 0, 3.0},
+        {"binary representation error", 1.005, 2, 1.01},
+        {"negative number", -2.675, 2, -2.68},
+        {"negative decimals", 1250, -2, 1300},
+        {"negative decimals round down", 1234.5, -3, 1000},
     }
 
     for _, tt := range tests {
@@ -333,6 +341,52 @@ func TestShuffle(t *testing.T) {
     }
 }
 
+func TestShuffleWith(t *testing.T) {
+    original := Range(0, 20)
+
+    // Pinned so a change to the shuffle algorithm or the seeded source fails the test
+    first := ShuffleWith(original, NewSeededRand(42))
+    want := []int{13, 16, 4, 2, 10, 15, 6, 1, 17, 0, 5, 19, 9, 14, 3, 18, 8, 11, 7, 12}
+    if !reflect.DeepEqual(first, want) {
+        t.Errorf("ShuffleWith() with seed 42 = %v, want %v", first, want)
+    }
+    for i := 0; i < 5; i++ {
+        if got := ShuffleWith(original, NewSeededRand(42)); !reflect.DeepEqual(got, first) {
+            t.Fatalf("ShuffleWith() with seed 42 = %v, want %v", got, first)
+        }
+    }
+    if reflect.DeepEqual(first, ShuffleWith(original, NewSeededRand(43))) {
+        t.Errorf("ShuffleWith() gave the same order for seeds 42 and 43")
+    }
+    if !reflect.DeepEqual(original, Range(0, 20)) {
+        t.Errorf("ShuffleWith() modified its input")
+    }
+
+    sorted := append([]int(nil), first...)
+    sort.Ints(sorted)
+    if !reflect.DeepEqual(sorted, original) {
+        t.Errorf("ShuffleWith() = %v, not a permutation of %v", first, original)
+    }
+}
+
+func TestShuffleInPlace(t *testing.T) {
+    slice := Range(0, 20)
+    ShuffleInPlaceWith(slice, NewSeededRand(42))
+    if want := ShuffleWith(Range(0, 20), NewSeededRand(42)); !reflect.DeepEqual(slice, want) {
+        t.Errorf("ShuffleInPlaceWith() = %v, want %v", slice, want)
+    }
+
+    slice = Range(0, 20)
+    ShuffleInPlace(slice)
+    sort.Ints(slice)
+    if !reflect.DeepEqual(slice, Range(0, 20)) {
+        t.Errorf("ShuffleInPlace() did not produce a permutation")
+    }
+
+    var empty []int
+    ShuffleInPlace(empty)
+}
+
 func TestFilter(t *testing.T) {
     tests := []struct {
         name     string
@@ -600,4 +654,450 @@ func TestUnion(t *testing.T) {
             }
         })
     }
-}
\ No newline at end of file
+}
+
+func TestNumberKinds(t *testing.T) {
+    t.Run("int64", func(t *testing.T) {
+        nums := []int64{4, -9, 12, 3}
+        if got := Min(int64(4), int64(-9)); got != -9 {
+            t.Errorf("Min() = %v, want %v", got, -9)
+        }
+        if got, _ := MaxInSlice(nums); got != 12 {
+            t.Errorf("MaxInSlice() = %v, want %v", got, 12)
+        }
+        if got := Sum(nums); got != 10 {
+            t.Errorf("Sum() = %v, want %v", got, 10)
+        }
+    })
+
+    t.Run("uint32", func(t *testing.T) {
+        nums := []uint32{7, 2, 9}
+        if got := Max(uint32(7), uint32(2)); got != 7 {
+            t.Errorf("Max() = %v, want %v", got, 7)
+        }
+        if got, _ := MinInSlice(nums); got != 2 {
+            t.Errorf("MinInSlice() = %v, want %v", got, 2)
+        }
+        if got, _ := Average(nums); got != 6 {
+            t.Errorf("Average() = %v, want %v", got, 6)
+        }
+    })
+
+    t.Run("small integer kinds", func(t *testing.T) {
+        if got, _ := Average([]int8{100, 100}); got != 100 {
+            t.Errorf("Average() of int8 = %v, want %v", got, 100)
+        }
+        if got, _ := Average([]int8{-128, -128, 127}); got != -43 {
+            t.Errorf("Average() of int8 = %v, want %v", got, -43)
+        }
+        if got, _ := Average([]uint8{200, 250}); got != 225 {
+            t.Errorf("Average() of uint8 = %v, want %v", got, 225)
+        }
+    })
+
+    t.Run("float64", func(t *testing.T) {
+        nums := []float64{1.5, -2.25, 0.75}
+        if got, _ := MinInSlice(nums); got != -2.25 {
+            t.Errorf("MinInSlice() = %v, want %v", got, -2.25)
+        }
+        if got := Sum(nums); got != 0 {
+            t.Errorf("Sum() = %v, want %v", got, 0)
+        }
+        if got, _ := Average([]float32{1, 2}); got != 1.5 {
+            t.Errorf("Average() = %v, want %v", got, 1.5)
+        }
+    })
+
+    t.Run("time.Duration", func(t *testing.T) {
+        nums := []time.Duration{time.Second, 500 * time.Millisecond, 2 * time.Second}
+        if got, _ := MinInSlice(nums); got != 500*time.Millisecond {
+            t.Errorf("MinInSlice() = %v, want %v", got, 500*time.Millisecond)
+        }
+        if got, _ := MaxInSlice(nums); got != 2*time.Second {
+            t.Errorf("MaxInSlice() = %v, want %v", got, 2*time.Second)
+        }
+        if got := Sum(nums); got != 3500*time.Millisecond {
+            t.Errorf("Sum() = %v, want %v", got, 3500*time.Millisecond)
+        }
+    })
+
+    t.Run("empty slice", func(t *testing.T) {
+        if _, err := MaxInSlice([]float64{}); !errors.Is(err, ErrEmptySlice) {
+            t.Errorf("MaxInSlice() error = %v, want %v", err, ErrEmptySlice)
+        }
+        if _, err := Average([]int64{}); !errors.Is(err, ErrEmptySlice) {
+            t.Errorf("Average() error = %v, want %v", err, ErrEmptySlice)
+        }
+    })
+}
+
+func TestNaN(t *testing.T) {
+    nan := math.NaN()
+    tests := []struct {
+        name string
+        got  float64
+    }{
+        {"Min first NaN", Min(nan, 1)},
+        {"Min second NaN", Min(1, nan)},
+        {"Max first NaN", Max(nan, 1)},
+        {"Max second NaN", Max(1, nan)},
+        {"Sum with NaN", Sum([]float64{1, nan, 2})},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if !math.IsNaN(tt.got) {
+                t.Errorf("%s = %v, want NaN", tt.name, tt.got)
+            }
+        })
+    }
+
+    t.Run("slices with NaN", func(t *testing.T) {
+        for _, nums := range [][]float64{{nan, 1, 2}, {1, nan, 2}, {1, 2, nan}} {
+            if got, _ := MinInSlice(nums); !math.IsNaN(got) {
+                t.Errorf("MinInSlice(%v) = %v, want NaN", nums, got)
+            }
+            if got, _ := MaxInSlice(nums); !math.IsNaN(got) {
+                t.Errorf("MaxInSlice(%v) = %v, want NaN", nums, got)
+            }
+            if got, _ := Average(nums); !math.IsNaN(got) {
+                t.Errorf("Average(%v) = %v, want NaN", nums, got)
+            }
+        }
+    })
+}
+
+func TestSumChecked(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []int64
+        expected int64
+        wantErr  error
+    }{
+        {"normal sum", []int64{1, 2, 3}, 6, nil},
+        {"empty slice", []int64{}, 0, nil},
+        {"max value", []int64{math.MaxInt64 - 1, 1}, math.MaxInt64, nil},
+        {"positive overflow", []int64{math.MaxInt64, 1}, 0, ErrOverflow},
+        {"negative overflow", []int64{math.MinInt64, -1}, 0, ErrOverflow},
+        {"cancels out", []int64{math.MaxInt64, math.MinInt64}, -1, nil},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := SumChecked(tt.slice)
+            if !errors.Is(err, tt.wantErr) {
+                t.Errorf("SumChecked() error = %v, want %v", err, tt.wantErr)
+                return
+            }
+            if got != tt.expected {
+                t.Errorf("SumChecked() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    t.Run("unsigned overflow", func(t *testing.T) {
+        if _, err := SumChecked([]uint8{200, 100}); !errors.Is(err, ErrOverflow) {
+            t.Errorf("SumChecked() error = %v, want %v", err, ErrOverflow)
+        }
+    })
+}
+
+func TestSumBig(t *testing.T) {
+    got := SumBig([]int64{math.MaxInt64, math.MaxInt64, 2})
+    expected := new(big.Int).Lsh(big.NewInt(1), 64)
+    if got.Cmp(expected) != 0 {
+        t.Errorf("SumBig() = %v, want %v", got, expected)
+    }
+
+    got = SumBig([]uint64{math.MaxUint64, 1})
+    if got.Cmp(expected) != 0 {
+        t.Errorf("SumBig() = %v, want %v", got, expected)
+    }
+
+    got = SumBig([]int8{-128, -128, 1})
+    if got.Int64() != -255 {
+        t.Errorf("SumBig() = %v, want %v", got, -255)
+    }
+}
+
+func TestSumBigFloat(t *testing.T) {
+    got, err := SumBigFloat([]float64{1e308, 1e308, -1e308, 1})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := new(big.Float).SetPrec(bigFloatPrec).SetFloat64(1e308)
+    expected.Add(expected, big.NewFloat(1))
+    if got.Cmp(expected) != 0 {
+        t.Errorf("SumBigFloat() = %v, want %v", got, expected)
+    }
+
+    if _, err := SumBigFloat([]float64{1, math.NaN()}); !errors.Is(err, ErrNotFinite) {
+        t.Errorf("SumBigFloat() error = %v, want %v", err, ErrNotFinite)
+    }
+    if _, err := SumBigFloat([]float32{float32(math.Inf(1))}); !errors.Is(err, ErrNotFinite) {
+        t.Errorf("SumBigFloat() error = %v, want %v", err, ErrNotFinite)
+    }
+}
+
+func TestAverageBig(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []int64
+        expected float64
+        wantErr  error
+    }{
+        {"normal average", []int64{1, 2, 3, 4}, 2.5, nil},
+        {"overflowing sum", []int64{math.MaxInt64, math.MaxInt64}, math.MaxInt64, nil},
+        {"negative overflowing sum", []int64{math.MinInt64, math.MinInt64, 0, 0}, math.MinInt64 / 2, nil},
+        {"empty slice", []int64{}, 0, ErrEmptySlice},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := AverageBig(tt.slice)
+            if !errors.Is(err, tt.wantErr) {
+                t.Errorf("AverageBig() error = %v, want %v", err, tt.wantErr)
+                return
+            }
+            if got != tt.expected {
+                t.Errorf("AverageBig() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+
+    t.Run("small integer kinds", func(t *testing.T) {
+        if got, _ := AverageBig([]uint8{250, 250, 250}); got != 250 {
+            t.Errorf("AverageBig() = %v, want %v", got, 250)
+        }
+    })
+}
+
+func TestSumFloat(t *testing.T) {
+    tenths := make([]float64, 1000000)
+    for i := range tenths {
+        tenths[i] = 0.1
+    }
+
+    tests := []struct {
+        name     string
+        slice    []float64
+        method   SumMethod
+        expected float64
+    }{
+        {"neumaier many small terms", tenths, SumNeumaier, 100000},
+        {"kahan many small terms", tenths, SumKahan, 100000},
+        {"pairwise many small terms", tenths, SumPairwise, 100000},
+        {"neumaier ten tenths", tenths[:10], SumNeumaier, 1},
+        {"kahan ten tenths", tenths[:10], SumKahan, 1},
+        {"neumaier large cancelling terms", []float64{1, 1e100, 1, -1e100}, SumNeumaier, 2},
+        {"neumaier mixed magnitudes", []float64{1e16, 1, -1e16}, SumNeumaier, 1},
+        {"unknown method uses neumaier", []float64{1, 1e100, 1, -1e100}, SumMethod(99), 2},
+        {"naive", []float64{1, 2, 3}, SumNaive, 6},
+        {"empty slice", []float64{}, SumPairwise, 0},
+        {"neumaier leading infinity", []float64{math.Inf(1), 1}, SumNeumaier, math.Inf(1)},
+        {"neumaier trailing infinity", []float64{1, math.Inf(1)}, SumNeumaier, math.Inf(1)},
+        {"neumaier negative infinity", []float64{2, math.Inf(-1), 1}, SumNeumaier, math.Inf(-1)},
+        {"neumaier overflow", []float64{1e308, 1e308, -1e308}, SumNeumaier, math.Inf(1)},
+        {"kahan leading infinity", []float64{math.Inf(1), 1}, SumKahan, math.Inf(1)},
+        {"kahan trailing infinity", []float64{1, math.Inf(1)}, SumKahan, math.Inf(1)},
+        {"kahan negative infinity", []float64{2, math.Inf(-1), 1}, SumKahan, math.Inf(-1)},
+        {"pairwise infinity", []float64{math.Inf(1), 1}, SumPairwise, math.Inf(1)},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := SumFloat(tt.slice, tt.method)
+            if got != tt.expected {
+                t.Errorf("SumFloat() = %.17g, want %.17g", got, tt.expected)
+            }
+        })
+    }
+
+    t.Run("naive loses precision", func(t *testing.T) {
+        if got := SumFloat(tenths, SumNaive); got == 100000 {
+            t.Errorf("SumFloat() with SumNaive = %.17g, expected rounding error", got)
+        }
+    })
+
+    t.Run("float32 input", func(t *testing.T) {
+        if got := SumFloat([]float32{0.5, 0.25}, SumKahan); got != 0.75 {
+            t.Errorf("SumFloat() = %v, want %v", got, 0.75)
+        }
+    })
+
+    t.Run("opposite infinities", func(t *testing.T) {
+        for _, method := range []SumMethod{SumNeumaier, SumKahan, SumPairwise, SumNaive} {
+            if got := SumFloat([]float64{math.Inf(1), 1, math.Inf(-1)}, method); !math.IsNaN(got) {
+                t.Errorf("SumFloat() with method %d = %v, want NaN", method, got)
+            }
+        }
+    })
+}
+
+func TestAverageFloat(t *testing.T) {
+    got, err := AverageFloat([]float64{1e100, 1, -1e100, 3}, SumNeumaier)
+    if err != nil || got != 1 {
+        t.Errorf("AverageFloat() = %v, %v, want 1, nil", got, err)
+    }
+    if got, err := AverageFloat([]float64{math.Inf(1), 1}, SumNeumaier); err != nil || !math.IsInf(got, 1) {
+        t.Errorf("AverageFloat() = %v, %v, want +Inf, nil", got, err)
+    }
+    if _, err := AverageFloat([]float64{}, SumNeumaier); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("AverageFloat() error = %v, want %v", err, ErrEmptySlice)
+    }
+}
+
+func TestMapErr(t *testing.T) {
+    tests := []struct {
+        name     string
+        slice    []string
+        mode     ErrorMode
+        expected []int
+        errIndex []int
+    }{
+        {"all valid", []string{"1", "2", "3"}, StopOnError, []int{1, 2, 3}, nil},
+        {"stop at first error", []string{"1", "x", "3", "y"}, StopOnError, nil, []int{1}},
+        {"collect all errors", []string{"1", "x", "3", "y"}, CollectErrors, []int{1, 0, 3, 0}, []int{1, 3}},
+        {"empty slice", []string{}, StopOnError, []int{}, nil},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := MapErr(tt.slice, tt.mode, strconv.Atoi)
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("MapErr() = %v, want %v", got, tt.expected)
+            }
+            checkIndexErrors(t, err, tt.errIndex)
+        })
+    }
+}
+
+// checkIndexErrors verifies that err wraps one *IndexError per expected index, in order
+func checkIndexErrors(t *testing.T, err error, indices []int) {
+    t.Helper()
+    if len(indices) == 0 {
+        if err != nil {
+            t.Errorf("unexpected error: %v", err)
+        }
+        return
+    }
+    var errs []error
+    if joined, ok := err.(interface{ Unwrap() []error }); ok {
+        errs = joined.Unwrap()
+    } else {
+        errs = []error{err}
+    }
+    if len(errs) != len(indices) {
+        t.Fatalf("got %d errors (%v), want %d", len(errs), err, len(indices))
+    }
+    for i, e := range errs {
+        var indexErr *IndexError
+        if !errors.As(e, &indexErr) || indexErr.Index != indices[i] {
+            t.Errorf("error %d = %v, want index %d", i, e, indices[i])
+        }
+    }
+}
+
+func TestFilterErr(t *testing.T) {
+    positive := func(s string) (bool, error) {
+        n, err := strconv.Atoi(s)
+        return n > 0, err
+    }
+
+    got, err := FilterErr([]string{"1", "-2", "3"}, StopOnError, positive)
+    if err != nil || !reflect.DeepEqual(got, []string{"1", "3"}) {
+        t.Errorf("FilterErr() = %v, %v", got, err)
+    }
+
+    got, err = FilterErr([]string{"1", "x", "3"}, StopOnError, positive)
+    if got != nil {
+        t.Errorf("FilterErr() = %v, want nil", got)
+    }
+    checkIndexErrors(t, err, []int{1})
+
+    got, err = FilterErr([]string{"x", "1", "-2", "y", "3"}, CollectErrors, positive)
+    if !reflect.DeepEqual(got, []string{"1", "3"}) {
+        t.Errorf("FilterErr() = %v, want [1 3]", got)
+    }
+    checkIndexErrors(t, err, []int{0, 3})
+}
+
+func TestReduceErr(t *testing.T) {
+    add := func(acc int, s string) (int, error) {
+        n, err := strconv.Atoi(s)
+        return acc + n, err
+    }
+
+    got, err := ReduceErr([]string{"1", "2", "3"}, 10, StopOnError, add)
+    if err != nil || got != 16 {
+        t.Errorf("ReduceErr() = %v, %v, want 16", got, err)
+    }
+
+    got, err = ReduceErr([]string{"1", "x", "3"}, 10, StopOnError, add)
+    if got != 0 {
+        t.Errorf("ReduceErr() = %v, want 0", got)
+    }
+    checkIndexErrors(t, err, []int{1})
+
+    got, err = ReduceErr([]string{"1", "x", "3", "y"}, 10, CollectErrors, add)
+    if got != 14 {
+        t.Errorf("ReduceErr() = %v, want 14", got)
+    }
+    checkIndexErrors(t, err, []int{1, 3})
+}
+
+func TestGroupByErr(t *testing.T) {
+    parity := func(s string) (string, error) {
+        n, err := strconv.Atoi(s)
+        if n%2 == 0 {
+            return "even", err
+        }
+        return "odd", err
+    }
+
+    got, err := GroupByErr([]string{"1", "2", "3"}, StopOnError, parity)
+    if err != nil || !reflect.DeepEqual(got, map[string][]string{"odd": {"1", "3"}, "even": {"2"}}) {
+        t.Errorf("GroupByErr() = %v, %v", got, err)
+    }
+
+    got, err = GroupByErr([]string{"1", "x"}, StopOnError, parity)
+    if got != nil {
+        t.Errorf("GroupByErr() = %v, want nil", got)
+    }
+    checkIndexErrors(t, err, []int{1})
+
+    got, err = GroupByErr([]string{"x", "4", "y", "5"}, CollectErrors, parity)
+    if !reflect.DeepEqual(got, map[string][]string{"odd": {"5"}, "even": {"4"}}) {
+        t.Errorf("GroupByErr() = %v", got)
+    }
+    checkIndexErrors(t, err, []int{0, 2})
+}
+
+func TestForEachErr(t *testing.T) {
+    var visited []string
+    visit := func(s string) error {
+        visited = append(visited, s)
+        _, err := strconv.Atoi(s)
+        return err
+    }
+
+    checkIndexErrors(t, ForEachErr([]string{"1", "x", "3"}, StopOnError, visit), []int{1})
+    if !reflect.DeepEqual(visited, []string{"1", "x"}) {
+        t.Errorf("ForEachErr() visited %v, want it to stop at x", visited)
+    }
+
+    visited = nil
+    checkIndexErrors(t, ForEachErr([]string{"x", "2", "y"}, CollectErrors, visit), []int{0, 2})
+    if len(visited) != 3 {
+        t.Errorf("ForEachErr() visited %v, want every element", visited)
+    }
+
+    if err := ForEachErr([]string{"4"}, StopOnError, visit); err != nil {
+        t.Errorf("unexpected error: %v", err)
+    }
+
+    var numErr *strconv.NumError
+    if err := ForEachErr([]string{"z"}, StopOnError, visit); !errors.As(err, &numErr) {
+        t.Errorf("ForEachErr() error = %v, want it to wrap *strconv.NumError", err)
+    }
+}
@@ -0,0 +1,249 @@
+package gohelpers
+
+import (
+    "errors"
+    "math"
+)
+
+// ErrInvalidAlpha is returned for an exponential moving average smoothing factor outside (0, 1]
+var ErrInvalidAlpha = errors.New("alpha must be in (0, 1]")
+
+// SlidingWindow returns every full window of size elements, starting a new window every step elements.
+// Like Chunk, the windows share the input's backing array, so overlapping windows see the same elements.
+func SlidingWindow[T any](slice []T, size, step int) [][]T {
+    if size <= 0 || step <= 0 || size > len(slice) {
+        return [][]T{}
+    }
+    windows := make([][]T, 0, (len(slice)-size)/step+1)
+    for i := 0; i+size <= len(slice); i += step {
+        windows = append(windows, slice[i:i+size:i+size])
+    }
+    return windows
+}
+
+// indexed is a value tagged with its position in the stream, used by the monotonic deques
+type indexed[T any] struct {
+    index int
+    value T
+}
+
+// MovingWindow keeps O(1) amortized running aggregates over the last size values pushed into it.
+// Min and Max use monotonic deques, so no window is ever rescanned. The running sum is compensated,
+// so for floats the rounding error of one large value does not linger after it leaves the window.
+// NaN and infinite values are counted separately rather than added to the sum, so they affect Sum
+// and Mean only while they are inside the window.
+type MovingWindow[T Number] struct {
+    size   int
+    pushed int
+    values []T
+    sum    T
+    comp   T
+    nans   int
+    posInf int
+    negInf int
+    mins   []indexed[T]
+    maxs   []indexed[T]
+}
+
+// NewMovingWindow returns an empty window over the last size values (at least 1)
+func NewMovingWindow[T Number](size int) *MovingWindow[T] {
+    size = Max(size, 1)
+    return &MovingWindow[T]{size: size, values: make([]T, 0, size)}
+}
+
+// Push adds a value, evicting the oldest one once the window is full
+func (w *MovingWindow[T]) Push(x T) {
+    slot := w.pushed % w.size
+    if len(w.values) < w.size {
+        w.values = append(w.values, x)
+    } else {
+        w.account(w.values[slot], -1)
+        w.values[slot] = x
+    }
+    w.account(x, 1)
+    if !isFinite(w.sum) {
+        w.resum()
+    }
+
+    oldest := w.pushed - w.size + 1
+    w.mins = pushMonotonic(w.mins, indexed[T]{w.pushed, x}, oldest, func(a, b T) bool { return a <= b })
+    w.maxs = pushMonotonic(w.maxs, indexed[T]{w.pushed, x}, oldest, func(a, b T) bool { return a >= b })
+    w.pushed++
+}
+
+// account adds (sign 1) or removes (sign -1) x from the running sum, or from the non-finite counts
+func (w *MovingWindow[T]) account(x T, sign int) {
+    switch {
+    case isNaN(x):
+        w.nans += sign
+    case isFinite(x):
+        if sign < 0 {
+            x = -x
+        }
+        w.sum, w.comp = neumaierAdd(w.sum, w.comp, x)
+    case x > 0:
+        w.posInf += sign
+    default:
+        w.negInf += sign
+    }
+}
+
+// resum recomputes the running sum from the finite values in the window. It is only needed when
+// finite values overflow, since removing a value from an infinite sum would otherwise never recover.
+func (w *MovingWindow[T]) resum() {
+    var sum, comp T
+    for _, v := range w.values {
+        if isFinite(v) {
+            sum, comp = neumaierAdd(sum, comp, v)
+        }
+    }
+    w.sum, w.comp = sum, comp
+}
+
+// pushMonotonic appends item to a deque whose values stay ordered by keep, dropping entries older than oldest
+func pushMonotonic[T Number](deque []indexed[T], item indexed[T], oldest int, keep func(a, b T) bool) []indexed[T] {
+    for len(deque) > 0 && !keep(deque[len(deque)-1].value, item.value) {
+        deque = deque[:len(deque)-1]
+    }
+    deque = append(deque, item)
+    for deque[0].index < oldest {
+        deque = deque[1:]
+    }
+    return deque
+}
+
+// Len returns the number of values currently in the window
+func (w *MovingWindow[T]) Len() int {
+    return len(w.values)
+}
+
+// Full reports whether the window holds size values
+func (w *MovingWindow[T]) Full() bool {
+    return len(w.values) == w.size
+}
+
+// Sum returns the sum of the values in the window. It is NaN while the window holds a NaN or both
+// infinities, and ±Inf while it holds infinities of one sign.
+func (w *MovingWindow[T]) Sum() T {
+    switch {
+    case w.nans > 0 || (w.posInf > 0 && w.negInf > 0):
+        return T(math.NaN())
+    case w.posInf > 0:
+        return T(math.Inf(1))
+    case w.negInf > 0:
+        return T(math.Inf(-1))
+    }
+    return w.sum + w.comp
+}
+
+// Mean returns the average of the values in the window
+func (w *MovingWindow[T]) Mean() (float64, error) {
+    if len(w.values) == 0 {
+        return 0, ErrEmptySlice
+    }
+    return float64(w.Sum()) / float64(len(w.values)), nil
+}
+
+// Min returns the smallest value in the window
+func (w *MovingWindow[T]) Min() (T, error) {
+    if len(w.mins) == 0 {
+        return 0, ErrEmptySlice
+    }
+    return w.mins[0].value, nil
+}
+
+// Max returns the largest value in the window
+func (w *MovingWindow[T]) Max() (T, error) {
+    if len(w.maxs) == 0 {
+        return 0, ErrEmptySlice
+    }
+    return w.maxs[0].value, nil
+}
+
+// rolling pushes nums through a MovingWindow and collects f once each full window is reached
+func rolling[T Number, U any](nums []T, window int, f func(w *MovingWindow[T]) U) []U {
+    if window <= 0 || window > len(nums) {
+        return []U{}
+    }
+    w := NewMovingWindow[T](window)
+    result := make([]U, 0, len(nums)-window+1)
+    for _, num := range nums {
+        w.Push(num)
+        if w.Full() {
+            result = append(result, f(w))
+        }
+    }
+    return result
+}
+
+// RollingSum returns the sum of each full window of the given size in O(n)
+func RollingSum[T Number](nums []T, window int) []T {
+    return rolling(nums, window, (*MovingWindow[T]).Sum)
+}
+
+// RollingMean returns the average of each full window of the given size in O(n)
+func RollingMean[T Number](nums []T, window int) []float64 {
+    return rolling(nums, window, func(w *MovingWindow[T]) float64 {
+        mean, _ := w.Mean()
+        return mean
+    })
+}
+
+// RollingMin returns the smallest value of each full window of the given size in O(n)
+func RollingMin[T Number](nums []T, window int) []T {
+    return rolling(nums, window, func(w *MovingWindow[T]) T {
+        min, _ := w.Min()
+        return min
+    })
+}
+
+// RollingMax returns the largest value of each full window of the given size in O(n)
+func RollingMax[T Number](nums []T, window int) []T {
+    return rolling(nums, window, func(w *MovingWindow[T]) T {
+        max, _ := w.Max()
+        return max
+    })
+}
+
+// EMA is an exponential moving average updated one value at a time
+type EMA struct {
+    alpha   float64
+    value   float64
+    started bool
+}
+
+// NewEMA returns an exponential moving average with smoothing factor alpha in (0, 1];
+// larger values weight recent values more heavily
+func NewEMA(alpha float64) (*EMA, error) {
+    if !(alpha > 0 && alpha <= 1) {
+        return nil, ErrInvalidAlpha
+    }
+    return &EMA{alpha: alpha}, nil
+}
+
+// Push adds a value and returns the updated average; the first value seeds the average
+func (e *EMA) Push(x float64) float64 {
+    if !e.started {
+        e.value, e.started = x, true
+    } else {
+        e.value += e.alpha * (x - e.value)
+    }
+    return e.value
+}
+
+// Value returns the current average
+func (e *EMA) Value() (float64, error) {
+    if !e.started {
+        return 0, ErrEmptySlice
+    }
+    return e.value, nil
+}
+
+// ExponentialMovingAverage returns the exponential moving average after each value
+func ExponentialMovingAverage[T Number](nums []T, alpha float64) ([]float64, error) {
+    e, err := NewEMA(alpha)
+    if err != nil {
+        return nil, err
+    }
+    return Map(nums, func(x T) float64 { return e.Push(float64(x)) }), nil
+}
@@ -0,0 +1,207 @@
+package gohelpers
+
+import (
+    "errors"
+    "math"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+func TestSlidingWindow(t *testing.T) {
+    tests := []struct {
+        name       string
+        slice      []int
+        size, step int
+        expected   [][]int
+    }{
+        {"overlapping", []int{1, 2, 3, 4, 5}, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+        {"step two", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+        {"step larger than size", []int{1, 2, 3, 4, 5, 6}, 2, 3, [][]int{{1, 2}, {4, 5}}},
+        {"size equals length", []int{1, 2, 3}, 3, 1, [][]int{{1, 2, 3}}},
+        {"size larger than length", []int{1, 2}, 3, 1, [][]int{}},
+        {"size zero", []int{1, 2, 3}, 0, 1, [][]int{}},
+        {"step zero", []int{1, 2, 3}, 1, 0, [][]int{}},
+        {"empty slice", []int{}, 1, 1, [][]int{}},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := SlidingWindow(tt.slice, tt.size, tt.step)
+            if !reflect.DeepEqual(got, tt.expected) {
+                t.Errorf("SlidingWindow() = %v, want %v", got, tt.expected)
+            }
+        })
+    }
+}
+
+func TestRollingAggregates(t *testing.T) {
+    nums := []int{4, 2, 12, 3, 8, 1, 7}
+
+    if got := RollingSum(nums, 3); !reflect.DeepEqual(got, []int{18, 17, 23, 12, 16}) {
+        t.Errorf("RollingSum() = %v", got)
+    }
+    if got := RollingMean(nums, 2); !reflect.DeepEqual(got, []float64{3, 7, 7.5, 5.5, 4.5, 4}) {
+        t.Errorf("RollingMean() = %v", got)
+    }
+    if got := RollingMin(nums, 3); !reflect.DeepEqual(got, []int{2, 2, 3, 1, 1}) {
+        t.Errorf("RollingMin() = %v", got)
+    }
+    if got := RollingMax(nums, 3); !reflect.DeepEqual(got, []int{12, 12, 12, 8, 8}) {
+        t.Errorf("RollingMax() = %v", got)
+    }
+    if got := RollingSum(nums, 0); !reflect.DeepEqual(got, []int{}) {
+        t.Errorf("RollingSum() with window 0 = %v, want []", got)
+    }
+    if got := RollingMax(nums, 10); !reflect.DeepEqual(got, []int{}) {
+        t.Errorf("RollingMax() with window larger than slice = %v, want []", got)
+    }
+}
+
+func TestRollingMixedMagnitudes(t *testing.T) {
+    // A naive running sum loses the small values added next to the spike and never recovers them
+    if got := RollingSum([]float64{1e17, 1, 1, 1, 1}, 2); !reflect.DeepEqual(got, []float64{1e17, 2, 2, 2}) {
+        t.Errorf("RollingSum() = %v, want [1e17 2 2 2]", got)
+    }
+    got := RollingMean([]float64{1e16, .1, .2, .3, .4}, 2)
+    for i, want := range []float64{5e15, .15, .25, .35} {
+        if math.Abs(got[i]-want) > 1e-12*math.Max(1, want) {
+            t.Errorf("RollingMean()[%d] = %v, want %v", i, got[i], want)
+        }
+    }
+
+    w := NewMovingWindow[float32](3)
+    for _, x := range []float32{1e9, 1, 2, 3} {
+        w.Push(x)
+    }
+    if w.Sum() != 6 {
+        t.Errorf("Sum() after the spike left = %v, want 6", w.Sum())
+    }
+
+    u := NewMovingWindow[uint8](2)
+    for _, x := range []uint8{200, 100, 50} {
+        u.Push(x)
+    }
+    if u.Sum() != 150 {
+        t.Errorf("Sum() of uint8 window = %v, want 150", u.Sum())
+    }
+}
+
+func TestRollingNonFinite(t *testing.T) {
+    inf, nan := math.Inf(1), math.NaN()
+    // equalFloats treats NaN as equal to NaN, so whole results can be compared
+    equalFloats := func(a, b []float64) bool {
+        if len(a) != len(b) {
+            return false
+        }
+        for i := range a {
+            if a[i] != b[i] && !(math.IsNaN(a[i]) && math.IsNaN(b[i])) {
+                return false
+            }
+        }
+        return true
+    }
+
+    tests := []struct {
+        name     string
+        nums     []float64
+        window   int
+        expected []float64
+    }{
+        {"infinity leaves the window", []float64{1, inf, 1, 1, 1}, 2, []float64{inf, inf, 2, 2}},
+        {"negative infinity leaves the window", []float64{1, -inf, 1, 1}, 2, []float64{-inf, -inf, 2}},
+        {"NaN leaves the window", []float64{1, nan, 1, 1, 1}, 2, []float64{nan, nan, 2, 2}},
+        {"opposite infinities", []float64{inf, -inf, 1, 1}, 2, []float64{nan, -inf, 2}},
+        {"finite overflow recovers", []float64{1e308, 1e308, 1, 1}, 2, []float64{inf, 1e308, 2}},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := RollingSum(tt.nums, tt.window); !equalFloats(got, tt.expected) {
+                t.Errorf("RollingSum() = %v, want %v", got, tt.expected)
+            }
+            means := Map(tt.expected, func(x float64) float64 { return x / float64(tt.window) })
+            if got := RollingMean(tt.nums, tt.window); !equalFloats(got, means) {
+                t.Errorf("RollingMean() = %v, want %v", got, means)
+            }
+        })
+    }
+}
+
+func TestRollingMatchesWindows(t *testing.T) {
+    r := rand.New(rand.NewSource(3))
+    nums := make([]int, 500)
+    for i := range nums {
+        nums[i] = r.Intn(50)
+    }
+
+    for _, size := range []int{1, 2, 7, 50} {
+        windows := SlidingWindow(nums, size, 1)
+        mins, maxs, sums := RollingMin(nums, size), RollingMax(nums, size), RollingSum(nums, size)
+        for i, w := range windows {
+            wantMin, _ := MinInSlice(w)
+            wantMax, _ := MaxInSlice(w)
+            if mins[i] != wantMin || maxs[i] != wantMax || sums[i] != Sum(w) {
+                t.Fatalf("window %d of size %d: got min %v max %v sum %v, want %v %v %v",
+                    i, size, mins[i], maxs[i], sums[i], wantMin, wantMax, Sum(w))
+            }
+        }
+    }
+}
+
+func TestMovingWindow(t *testing.T) {
+    w := NewMovingWindow[float64](3)
+    if _, err := w.Min(); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Min() error = %v, want %v", err, ErrEmptySlice)
+    }
+    if _, err := w.Mean(); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Mean() error = %v, want %v", err, ErrEmptySlice)
+    }
+
+    w.Push(5)
+    w.Push(1)
+    if w.Full() || w.Len() != 2 {
+        t.Errorf("Len() = %v, Full() = %v, want 2, false", w.Len(), w.Full())
+    }
+    if mean, _ := w.Mean(); mean != 3 {
+        t.Errorf("Mean() = %v, want 3", mean)
+    }
+
+    w.Push(3)
+    w.Push(4) // evicts 5
+    if !w.Full() || w.Sum() != 8 {
+        t.Errorf("Full() = %v, Sum() = %v, want true, 8", w.Full(), w.Sum())
+    }
+    if max, _ := w.Max(); max != 4 {
+        t.Errorf("Max() = %v, want 4", max)
+    }
+    w.Push(6) // evicts 1
+    if min, _ := w.Min(); min != 3 {
+        t.Errorf("Min() = %v, want 3", min)
+    }
+}
+
+func TestExponentialMovingAverage(t *testing.T) {
+    got, err := ExponentialMovingAverage([]int{10, 20, 20, 0}, 0.5)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(got, []float64{10, 15, 17.5, 8.75}) {
+        t.Errorf("ExponentialMovingAverage() = %v", got)
+    }
+
+    for _, alpha := range []float64{0, -0.5, 1.5, math.NaN()} {
+        if _, err := ExponentialMovingAverage([]int{1}, alpha); !errors.Is(err, ErrInvalidAlpha) {
+            t.Errorf("ExponentialMovingAverage(alpha=%v) error = %v, want %v", alpha, err, ErrInvalidAlpha)
+        }
+    }
+
+    e, _ := NewEMA(1)
+    if _, err := e.Value(); !errors.Is(err, ErrEmptySlice) {
+        t.Errorf("Value() error = %v, want %v", err, ErrEmptySlice)
+    }
+    e.Push(3)
+    e.Push(9)
+    if v, _ := e.Value(); v != 9 {
+        t.Errorf("Value() with alpha 1 = %v, want 9", v)
+    }
+}